@@ -2,23 +2,31 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/currency"
 	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/dateparse"
+	"github.com/LimerDev/worklog/internal/duration"
 	"github.com/LimerDev/worklog/internal/i18n"
 	"github.com/LimerDev/worklog/internal/models"
 	"github.com/spf13/cobra"
 )
 
 var (
-	hours       float64
-	description string
-	project     string
-	client      string
-	consultant  string
-	hourlyRate  float64
-	date        string
+	hours         string
+	description   string
+	project       string
+	client        string
+	consultant    string
+	hourlyRate    string
+	date          string
+	startTime     string
+	endTime       string
+	breakTime     string
+	allowInactive bool
 )
 
 var addCmd = &cobra.Command{
@@ -31,15 +39,18 @@ var addCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(addCmd)
 
-	addCmd.Flags().Float64VarP(&hours, "hours", "t", 0, "")
+	addCmd.Flags().StringVarP(&hours, "hours", "t", "", "")
 	addCmd.Flags().StringVarP(&description, "description", "d", "", "")
 	addCmd.Flags().StringVarP(&project, "project", "p", "", "")
 	addCmd.Flags().StringVarP(&client, "client", "c", "", "")
 	addCmd.Flags().StringVarP(&consultant, "consultant", "n", "", "")
-	addCmd.Flags().Float64VarP(&hourlyRate, "rate", "r", 0, "")
+	addCmd.Flags().StringVarP(&hourlyRate, "rate", "r", "", "")
 	addCmd.Flags().StringVarP(&date, "date", "D", "", "")
+	addCmd.Flags().StringVar(&startTime, "start", "", "")
+	addCmd.Flags().StringVar(&endTime, "end", "", "")
+	addCmd.Flags().StringVar(&breakTime, "break", "", "")
+	addCmd.Flags().BoolVar(&allowInactive, "allow-inactive", false, "")
 
-	addCmd.MarkFlagRequired("hours")
 	addCmd.MarkFlagRequired("description")
 }
 
@@ -54,6 +65,10 @@ func localizeAddCommand() {
 	addCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyAddFlagConsultant)
 	addCmd.Flags().Lookup("rate").Usage = i18n.T(i18n.KeyAddFlagRate)
 	addCmd.Flags().Lookup("date").Usage = i18n.T(i18n.KeyAddFlagDate)
+	addCmd.Flags().Lookup("start").Usage = i18n.T(i18n.KeyAddFlagStart)
+	addCmd.Flags().Lookup("end").Usage = i18n.T(i18n.KeyAddFlagEnd)
+	addCmd.Flags().Lookup("break").Usage = i18n.T(i18n.KeyAddFlagBreak)
+	addCmd.Flags().Lookup("allow-inactive").Usage = i18n.T(i18n.KeyAddFlagAllowInactive)
 }
 
 func runAdd(cmd *cobra.Command, args []string) error {
@@ -63,32 +78,15 @@ func runAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
 	}
 
-	// Use defaults if not provided
-	if consultant == "" {
-		consultant = cfg.DefaultConsultant
-	}
-	if client == "" {
-		client = cfg.DefaultClient
-	}
-	if project == "" {
-		project = cfg.DefaultProject
-	}
-	if hourlyRate == 0 {
-		hourlyRate = cfg.DefaultRate
+	rate, err := parseOptionalRate(hourlyRate)
+	if err != nil {
+		return err
 	}
 
-	// Validate required fields
-	if consultant == "" {
-		return fmt.Errorf("%s", i18n.T(i18n.KeyErrConsultantRequired))
-	}
-	if client == "" {
-		return fmt.Errorf("%s", i18n.T(i18n.KeyErrCustomerRequired))
-	}
-	if project == "" {
-		return fmt.Errorf("%s", i18n.T(i18n.KeyErrProjectRequired))
-	}
-	if hourlyRate <= 0 {
-		return fmt.Errorf("%s", i18n.T(i18n.KeyErrRateRequired))
+	// Use defaults if not provided and validate required fields
+	target, err := entryTarget{consultant: consultant, client: client, project: project, rate: rate, allowInactive: allowInactive}.withDefaults(cfg)
+	if err != nil {
+		return err
 	}
 
 	var entryDate time.Time
@@ -97,78 +95,365 @@ func runAdd(cmd *cobra.Command, args []string) error {
 		now := time.Now()
 		entryDate = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
 	} else {
-		parsedDate, err := time.Parse("2006-01-02", date)
+		entryDate, err = dateparse.Parse(date, time.Now())
 		if err != nil {
-			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrInvalidDateFormat), err)
+			return err
 		}
-		entryDate = time.Date(parsedDate.Year(), parsedDate.Month(), parsedDate.Day(), 0, 0, 0, 0, time.UTC)
 	}
 
-	if hours <= 0 {
-		return fmt.Errorf("%s", i18n.T(i18n.KeyErrHoursMustBePositive))
+	parsedHours, start, end, err := workedTime(entryDate)
+	if err != nil {
+		return err
 	}
 
 	repo := database.NewRepository()
 
-	// Get or create consultant
-	consultantObj, err := repo.GetOrCreateConsultant(consultant)
+	// The rate card in effect on the date of the entry applies, not the one of today
+	target, err = target.withRate(repo, cfg, entryDate)
 	if err != nil {
-		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateConsultant), err)
+		return err
 	}
 
-	// Get or create customer
-	customerObj, err := repo.GetOrCreateCustomer(client)
+	consultantObj, projectObj, err := target.resolve(repo)
 	if err != nil {
-		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateCustomer), err)
+		return err
 	}
 
-	// Get or create project for this customer
-	projectObj, err := repo.GetOrCreateProject(project, customerObj.ID)
-	if err != nil {
-		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateProject), err)
+	entry := &models.TimeEntry{
+		Date:         entryDate,
+		Hours:        parsedHours,
+		StartTime:    start,
+		EndTime:      end,
+		Description:  description,
+		HourlyRate:   target.rate,
+		ProjectID:    projectObj.ID,
+		Project:      *projectObj,
+		ConsultantID: consultantObj.ID,
+		Consultant:   *consultantObj,
 	}
 
-	// Check if there's an existing entry that matches all fields except hours
-	existingEntry, err := repo.FindMatchingTimeEntry(entryDate, consultantObj.ID, projectObj.ID, description, hourlyRate)
+	merged, err := saveTimeEntry(repo, entry)
 	if err != nil {
-		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCheckExistingEntry), err)
+		return err
 	}
 
-	var totalHours float64
-	if existingEntry != nil {
-		// Entry exists, update it by adding the hours
-		if err := repo.UpdateTimeEntryHours(existingEntry.ID, hours); err != nil {
-			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateWorkLog), err)
-		}
-		totalHours = existingEntry.Hours + hours
+	if merged {
 		fmt.Println(i18n.T(i18n.KeyAddSuccessMerged))
 	} else {
-		// No matching entry, create a new one
-		entry := &models.TimeEntry{
-			Date:         entryDate,
-			Hours:        hours,
-			Description:  description,
-			HourlyRate:   hourlyRate,
-			ProjectID:    projectObj.ID,
-			ConsultantID: consultantObj.ID,
+		fmt.Println(i18n.T(i18n.KeyAddSuccess))
+	}
+	if hours != "" {
+		printParsedHours(hours, parsedHours)
+	}
+	printTimeEntry(entry)
+
+	return warnOverlaps(repo, entry)
+}
+
+// workedTime derives the hours of an entry on entryDate, either from --hours or from
+// --start and --end minus --break. The start and end times are nil when --hours is used.
+func workedTime(entryDate time.Time) (float64, *time.Time, *time.Time, error) {
+	if startTime == "" && endTime == "" {
+		if breakTime != "" {
+			return 0, nil, nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrBreakWithoutTimes))
 		}
+		if hours == "" {
+			return 0, nil, nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrHoursOrTimes))
+		}
+		h, err := parseHours(hours)
+		return h, nil, nil, err
+	}
 
-		if err := repo.CreateTimeEntry(entry); err != nil {
-			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrSaveWorkLog), err)
+	if hours != "" {
+		return 0, nil, nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrHoursOrTimes))
+	}
+	if startTime == "" || endTime == "" {
+		return 0, nil, nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrStartEndTogether))
+	}
+
+	start, err := parseClock(entryDate, startTime)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	end, err := parseClock(entryDate, endTime)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if !end.After(start) {
+		return 0, nil, nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrEndBeforeStart))
+	}
+
+	worked := end.Sub(start).Hours()
+	if breakTime != "" {
+		b, err := duration.ParseHours(breakTime)
+		if err != nil {
+			return 0, nil, nil, err
 		}
-		totalHours = hours
-		fmt.Println(i18n.T(i18n.KeyAddSuccess))
+		if b >= worked {
+			return 0, nil, nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrBreakTooLong))
+		}
+		worked -= b
 	}
 
-	cost := totalHours * hourlyRate
-	fmt.Printf(i18n.T(i18n.KeyAddOutputDate)+"\n", entryDate.Format("2006-01-02"))
-	fmt.Printf(i18n.T(i18n.KeyAddOutputConsultant)+"\n", consultantObj.Name)
-	fmt.Printf(i18n.T(i18n.KeyAddOutputHours)+"\n", totalHours)
-	fmt.Printf(i18n.T(i18n.KeyAddOutputRate)+"\n", hourlyRate)
-	fmt.Printf(i18n.T(i18n.KeyAddOutputCost)+"\n", cost)
-	fmt.Printf(i18n.T(i18n.KeyAddOutputProject)+"\n", project)
-	fmt.Printf(i18n.T(i18n.KeyAddOutputCustomer)+"\n", client)
-	fmt.Printf(i18n.T(i18n.KeyAddOutputDescription)+"\n", description)
+	return worked, &start, &end, nil
+}
+
+// entryTarget names the consultant, customer and project a time entry is logged against, and its rate
+type entryTarget struct {
+	consultant    string
+	client        string
+	project       string
+	rate          models.Money
+	strict        bool // only customers that exist, see config.Config.Strict
+	allowInactive bool // also archived consultants, customers and projects, see resolve
+}
+
+// withDefaults fills empty names from the configured defaults and validates that the
+// consultant and project are set. The rate is filled in by withRate.
+func (t entryTarget) withDefaults(cfg *config.Config) (entryTarget, error) {
+	// A stray space would otherwise create a second customer, project or consultant
+	t.consultant = strings.TrimSpace(t.consultant)
+	t.client = strings.TrimSpace(t.client)
+	t.project = strings.TrimSpace(t.project)
+	t.strict = cfg.Strict
+
+	if t.consultant == "" {
+		t.consultant = cfg.DefaultConsultant
+	}
+	if t.client == "" {
+		t.client = cfg.DefaultClient
+	}
+	if t.project == "" {
+		t.project = cfg.DefaultProject
+	}
+	if t.consultant == "" {
+		return t, fmt.Errorf("%s", i18n.T(i18n.KeyErrConsultantRequired))
+	}
+	// The customer may be left out when the project is given by its code, see resolve
+	if t.project == "" {
+		return t, fmt.Errorf("%s", i18n.T(i18n.KeyErrProjectRequired))
+	}
+
+	return t, nil
+}
+
+// withRate fills in the rate when none was given: the rate card that applies to the
+// consultant, project and customer on the date, or else the default rate of the consultant,
+// or else the configured default rate. Names that do not exist yet have no rate cards.
+func (t entryTarget) withRate(repo *database.Repository, cfg *config.Config, date time.Time) (entryTarget, error) {
+	if t.rate == 0 {
+		card, err := t.rateCard(repo, date)
+		if err != nil {
+			return t, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchRateCards), err)
+		}
+		if card != nil {
+			t.rate = card.HourlyRate
+		}
+	}
+	if t.rate == 0 {
+		consultantObj, err := repo.GetConsultantByName(t.consultant)
+		if err != nil {
+			return t, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchConsultants), err)
+		}
+		if consultantObj != nil {
+			t.rate = consultantObj.DefaultRate
+		}
+	}
+	if t.rate == 0 {
+		rate, err := configuredRate(cfg)
+		if err != nil {
+			return t, err
+		}
+		t.rate = rate
+	}
+
+	if t.rate <= 0 {
+		return t, fmt.Errorf("%s", i18n.T(i18n.KeyErrRateRequired))
+	}
+	return t, nil
+}
+
+// rateCard returns the rate card that applies to the target on the date, or nil
+func (t entryTarget) rateCard(repo *database.Repository, date time.Time) (*models.RateCard, error) {
+	var consultantID, customerID, projectID uint
+
+	consultantObj, err := repo.GetConsultantByName(t.consultant)
+	if err != nil {
+		return nil, err
+	}
+	if consultantObj != nil {
+		consultantID = consultantObj.ID
+	}
+	projectObj, err := findProject(repo, t.project, t.client)
+	if err != nil {
+		return nil, err
+	}
+	if projectObj != nil {
+		projectID, customerID = projectObj.ID, projectObj.CustomerID
+	} else if t.client != "" {
+		customerObj, err := repo.GetCustomerByName(t.client)
+		if err != nil {
+			return nil, err
+		}
+		if customerObj != nil {
+			customerID = customerObj.ID
+		}
+	}
+
+	return repo.ResolveRateCard(consultantID, projectID, customerID, date)
+}
+
+// resolve gets or creates the consultant, customer and project. The project may be given by
+// its code, which also decides the customer. Otherwise the customer is only created as
+// customerFor allows. Archived consultants, customers and projects are refused unless
+// allowInactive is set. The returned project has its customer loaded.
+func (t entryTarget) resolve(repo *database.Repository) (*models.Consultant, *models.Project, error) {
+	// Get or create consultant
+	consultantObj, err := repo.GetOrCreateConsultant(t.consultant)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateConsultant), err)
+	}
+	if !t.allowInactive {
+		if err := checkConsultantActive(consultantObj); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	projectObj, err := findProject(repo, t.project, t.client)
+	if err != nil {
+		return nil, nil, err
+	}
+	if projectObj != nil {
+		if !t.allowInactive {
+			if err := checkProjectActive(projectObj); err != nil {
+				return nil, nil, err
+			}
+		}
+		return consultantObj, projectObj, nil
+	}
 
+	if t.client == "" {
+		return nil, nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrCustomerRequired))
+	}
+	customerObj, err := customerFor(repo, t.client, t.strict)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !t.allowInactive {
+		if err := checkCustomerActive(customerObj); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Create the project for this customer
+	projectObj, err = repo.GetOrCreateProject(t.project, customerObj.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateProject), err)
+	}
+	projectObj.Customer = *customerObj
+
+	return consultantObj, projectObj, nil
+}
+
+// customerFor returns the customer to log time against, creating it unless strict is set.
+// A name that differs from an existing customer only in case or spaces is refused as a typo.
+func customerFor(repo *database.Repository, name string, strict bool) (*models.Customer, error) {
+	customer, err := repo.GetCustomerByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateCustomer), err)
+	}
+	if customer != nil {
+		return customer, nil
+	}
+
+	similar, err := repo.FindSimilarCustomer(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateCustomer), err)
+	}
+	if similar != nil {
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrCustomerSimilar), name, similar.Name)
+	}
+	if strict {
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrCustomerStrict), name)
+	}
+
+	customer, err = repo.GetOrCreateCustomer(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateCustomer), err)
+	}
+	return customer, nil
+}
+
+// checkConsultantActive fails if the consultant is archived
+func checkConsultantActive(consultant *models.Consultant) error {
+	if !consultant.Active {
+		return fmt.Errorf(i18n.T(i18n.KeyErrConsultantArchived), consultant.Name)
+	}
+	return nil
+}
+
+// checkCustomerActive fails if the customer is archived
+func checkCustomerActive(customer *models.Customer) error {
+	if !customer.Active {
+		return fmt.Errorf(i18n.T(i18n.KeyErrCustomerArchived), customer.Name)
+	}
+	return nil
+}
+
+// checkProjectActive fails if the project or its customer is archived. The customer of the
+// project must be loaded.
+func checkProjectActive(project *models.Project) error {
+	if err := checkCustomerActive(&project.Customer); err != nil {
+		return err
+	}
+	if !project.Active {
+		return fmt.Errorf(i18n.T(i18n.KeyErrProjectArchived), project.Name, project.Customer.Name)
+	}
 	return nil
 }
+
+// saveTimeEntry stores a new entry, or adds its hours to an existing entry that matches
+// all fields except hours. On merge, entry takes the ID and total hours of the existing entry.
+// Entries with start and end times are always stored separately.
+func saveTimeEntry(repo *database.Repository, entry *models.TimeEntry) (merged bool, err error) {
+	// Check if there's an existing entry that matches all fields except hours
+	var existingEntry *models.TimeEntry
+	if !entry.HasTimes() {
+		existingEntry, err = repo.FindMatchingTimeEntry(entry.Date, entry.ConsultantID, entry.ProjectID, entry.Description, entry.HourlyRate)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCheckExistingEntry), err)
+		}
+	}
+
+	if existingEntry != nil {
+		// Entry exists, update it by adding the hours
+		if err := repo.UpdateTimeEntryHours(existingEntry.ID, entry.Hours); err != nil {
+			return false, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateWorkLog), err)
+		}
+		entry.ID = existingEntry.ID
+		entry.Hours += existingEntry.Hours
+		return true, nil
+	}
+
+	// No matching entry, create a new one
+	if err := repo.CreateTimeEntry(entry); err != nil {
+		return false, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrSaveWorkLog), err)
+	}
+	return false, nil
+}
+
+// printTimeEntry prints the summary shown after an entry has been saved
+func printTimeEntry(entry *models.TimeEntry) {
+	cost := currency.Format(models.Cost(entry.Hours, entry.HourlyRate), entry.Project.Customer.CurrencyCode())
+	fmt.Printf(i18n.T(i18n.KeyAddOutputDate)+"\n", entry.Date.Format("2006-01-02"))
+	if entry.HasTimes() {
+		fmt.Printf(i18n.T(i18n.KeyAddOutputTime)+"\n", entry.StartTime.Format("15:04"), entry.EndTime.Format("15:04"))
+	}
+	fmt.Printf(i18n.T(i18n.KeyAddOutputConsultant)+"\n", entry.Consultant.Name)
+	fmt.Printf(i18n.T(i18n.KeyAddOutputHours)+"\n", entry.Hours)
+	fmt.Printf(i18n.T(i18n.KeyAddOutputRate)+"\n", entry.HourlyRate.String())
+	fmt.Printf(i18n.T(i18n.KeyAddOutputCost)+"\n", cost)
+	fmt.Printf(i18n.T(i18n.KeyAddOutputProject)+"\n", entry.Project.Name)
+	fmt.Printf(i18n.T(i18n.KeyAddOutputCustomer)+"\n", entry.Project.Customer.Name)
+	fmt.Printf(i18n.T(i18n.KeyAddOutputDescription)+"\n", entry.Description)
+}
@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/LimerDev/worklog/internal/config"
 	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
 	"github.com/spf13/cobra"
 )
 
@@ -12,8 +14,19 @@ var (
 	configConsultant string
 	configClient     string
 	configProject    string
-	configRate       float64
+	configRate       string
 	configLanguage   string
+	configFiscalYear int
+	configDailyHours float64
+	configInvPrefix  string
+	configInvFirst   int
+	configInvDigits  int
+	configInvDueDays int
+	configInvVATRate float64
+	configRounding   string
+	configStrict     bool
+	configSeller     config.Seller
+	configSellerAddr []string
 	configDBHost     string
 	configDBPort     string
 	configDBUser     string
@@ -50,8 +63,26 @@ func init() {
 	configSetCmd.Flags().StringVarP(&configConsultant, "consultant", "n", "", "")
 	configSetCmd.Flags().StringVarP(&configClient, "client", "c", "", "")
 	configSetCmd.Flags().StringVarP(&configProject, "project", "p", "", "")
-	configSetCmd.Flags().Float64VarP(&configRate, "rate", "r", 0, "")
+	configSetCmd.Flags().StringVarP(&configRate, "rate", "r", "", "")
 	configSetCmd.Flags().StringVarP(&configLanguage, "language", "l", "", "")
+	configSetCmd.Flags().IntVar(&configFiscalYear, "fiscal-year-start", 0, "")
+	configSetCmd.Flags().Float64Var(&configDailyHours, "expected-daily-hours", 0, "")
+	configSetCmd.Flags().StringVar(&configInvPrefix, "invoice-prefix", "", "")
+	configSetCmd.Flags().IntVar(&configInvFirst, "invoice-first-number", 0, "")
+	configSetCmd.Flags().IntVar(&configInvDigits, "invoice-digits", 0, "")
+	configSetCmd.Flags().IntVar(&configInvDueDays, "invoice-due-days", 0, "")
+	configSetCmd.Flags().Float64Var(&configInvVATRate, "invoice-vat-rate", 0, "")
+	configSetCmd.Flags().StringVar(&configRounding, "rounding", "", "")
+	configSetCmd.Flags().BoolVar(&configStrict, "strict", false, "")
+	configSetCmd.Flags().StringVar(&configSeller.Name, "seller-name", "", "")
+	configSetCmd.Flags().StringArrayVar(&configSellerAddr, "seller-address", nil, "")
+	configSetCmd.Flags().StringVar(&configSeller.OrgNumber, "seller-org-number", "", "")
+	configSetCmd.Flags().StringVar(&configSeller.VATNumber, "seller-vat-number", "", "")
+	configSetCmd.Flags().StringVar(&configSeller.Email, "seller-email", "", "")
+	configSetCmd.Flags().StringVar(&configSeller.Phone, "seller-phone", "", "")
+	configSetCmd.Flags().StringVar(&configSeller.Bankgiro, "seller-bankgiro", "", "")
+	configSetCmd.Flags().StringVar(&configSeller.IBAN, "seller-iban", "", "")
+	configSetCmd.Flags().StringVar(&configSeller.BIC, "seller-bic", "", "")
 	configSetCmd.Flags().StringVar(&configDBHost, "db-host", "", "")
 	configSetCmd.Flags().StringVar(&configDBPort, "db-port", "", "")
 	configSetCmd.Flags().StringVar(&configDBUser, "db-user", "", "")
@@ -74,6 +105,24 @@ func localizeConfigCommand() {
 	configSetCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyConfigFlagProject)
 	configSetCmd.Flags().Lookup("rate").Usage = i18n.T(i18n.KeyConfigFlagRate)
 	configSetCmd.Flags().Lookup("language").Usage = i18n.T(i18n.KeyConfigFlagLanguage)
+	configSetCmd.Flags().Lookup("fiscal-year-start").Usage = i18n.T(i18n.KeyConfigFlagFiscalYearStart)
+	configSetCmd.Flags().Lookup("expected-daily-hours").Usage = i18n.T(i18n.KeyConfigFlagExpectedDailyHours)
+	configSetCmd.Flags().Lookup("invoice-prefix").Usage = i18n.T(i18n.KeyConfigFlagInvoicePrefix)
+	configSetCmd.Flags().Lookup("invoice-first-number").Usage = i18n.T(i18n.KeyConfigFlagInvoiceFirst)
+	configSetCmd.Flags().Lookup("invoice-digits").Usage = i18n.T(i18n.KeyConfigFlagInvoiceDigits)
+	configSetCmd.Flags().Lookup("invoice-due-days").Usage = i18n.T(i18n.KeyConfigFlagInvoiceDueDays)
+	configSetCmd.Flags().Lookup("invoice-vat-rate").Usage = i18n.T(i18n.KeyConfigFlagInvoiceVATRate)
+	configSetCmd.Flags().Lookup("rounding").Usage = i18n.T(i18n.KeyConfigFlagRounding)
+	configSetCmd.Flags().Lookup("strict").Usage = i18n.T(i18n.KeyConfigFlagStrict)
+	configSetCmd.Flags().Lookup("seller-name").Usage = i18n.T(i18n.KeyConfigFlagSellerName)
+	configSetCmd.Flags().Lookup("seller-address").Usage = i18n.T(i18n.KeyConfigFlagSellerAddress)
+	configSetCmd.Flags().Lookup("seller-org-number").Usage = i18n.T(i18n.KeyConfigFlagSellerOrgNumber)
+	configSetCmd.Flags().Lookup("seller-vat-number").Usage = i18n.T(i18n.KeyConfigFlagSellerVATNumber)
+	configSetCmd.Flags().Lookup("seller-email").Usage = i18n.T(i18n.KeyConfigFlagSellerEmail)
+	configSetCmd.Flags().Lookup("seller-phone").Usage = i18n.T(i18n.KeyConfigFlagSellerPhone)
+	configSetCmd.Flags().Lookup("seller-bankgiro").Usage = i18n.T(i18n.KeyConfigFlagSellerBankgiro)
+	configSetCmd.Flags().Lookup("seller-iban").Usage = i18n.T(i18n.KeyConfigFlagSellerIBAN)
+	configSetCmd.Flags().Lookup("seller-bic").Usage = i18n.T(i18n.KeyConfigFlagSellerBIC)
 	configSetCmd.Flags().Lookup("db-host").Usage = i18n.T(i18n.KeyConfigFlagDatabaseHost)
 	configSetCmd.Flags().Lookup("db-port").Usage = i18n.T(i18n.KeyConfigFlagDatabasePort)
 	configSetCmd.Flags().Lookup("db-user").Usage = i18n.T(i18n.KeyConfigFlagDatabaseUser)
@@ -110,6 +159,59 @@ func runConfigShow(cmd *cobra.Command, args []string) error {
 	if cfg.Language != "" {
 		fmt.Printf(i18n.T(i18n.KeyConfigLanguage)+"\n", cfg.Language)
 	}
+	if cfg.FiscalYearStartMonth > 0 {
+		fmt.Printf(i18n.T(i18n.KeyConfigFiscalYearStart)+"\n", cfg.FiscalYearStartMonth)
+	}
+	if cfg.ExpectedDailyHours > 0 {
+		fmt.Printf(i18n.T(i18n.KeyConfigExpectedDailyHours)+"\n", cfg.ExpectedDailyHours)
+	}
+	if cfg.Strict {
+		fmt.Println(i18n.T(i18n.KeyConfigStrict))
+	}
+
+	// The VAT rate always has a value, so the invoice section is always shown
+	fmt.Print(i18n.T(i18n.KeyConfigInvoiceTitle))
+	if cfg.Invoice.Prefix != "" {
+		fmt.Printf(i18n.T(i18n.KeyConfigInvoicePrefix)+"\n", cfg.Invoice.Prefix)
+	}
+	if cfg.Invoice.FirstNumber > 0 {
+		fmt.Printf(i18n.T(i18n.KeyConfigInvoiceFirst)+"\n", cfg.Invoice.FirstNumber)
+	}
+	if cfg.Invoice.Digits > 0 {
+		fmt.Printf(i18n.T(i18n.KeyConfigInvoiceDigits)+"\n", cfg.Invoice.Digits)
+	}
+	if cfg.Invoice.DueDays > 0 {
+		fmt.Printf(i18n.T(i18n.KeyConfigInvoiceDueDays)+"\n", cfg.Invoice.DueDays)
+	}
+	fmt.Printf(i18n.T(i18n.KeyConfigInvoiceVATRate)+"\n", cfg.Invoice.VATRate)
+	roundingKey := i18n.KeyConfigRoundingTotal
+	if roundingRule(cfg) == models.RoundPerLine {
+		roundingKey = i18n.KeyConfigRoundingLine
+	}
+	fmt.Printf(i18n.T(i18n.KeyConfigInvoiceRounding)+"\n", i18n.T(roundingKey))
+
+	if cfg.Seller != (config.Seller{}) {
+		fmt.Print(i18n.T(i18n.KeyConfigSellerTitle))
+		fields := []struct {
+			key   string
+			value string
+		}{
+			{i18n.KeyConfigSellerName, cfg.Seller.Name},
+			{i18n.KeyConfigSellerAddress, strings.ReplaceAll(cfg.Seller.Address, "\n", ", ")},
+			{i18n.KeyConfigSellerOrgNumber, cfg.Seller.OrgNumber},
+			{i18n.KeyConfigSellerVATNumber, cfg.Seller.VATNumber},
+			{i18n.KeyConfigSellerEmail, cfg.Seller.Email},
+			{i18n.KeyConfigSellerPhone, cfg.Seller.Phone},
+			{i18n.KeyConfigSellerBankgiro, cfg.Seller.Bankgiro},
+			{i18n.KeyConfigSellerIBAN, cfg.Seller.IBAN},
+			{i18n.KeyConfigSellerBIC, cfg.Seller.BIC},
+		}
+		for _, field := range fields {
+			if field.value != "" {
+				fmt.Printf(i18n.T(field.key)+"\n", field.value)
+			}
+		}
+	}
 
 	fmt.Print(i18n.T(i18n.KeyConfigDatabaseTitle))
 	if cfg.Database.Host != "" {
@@ -129,12 +231,64 @@ func runConfigShow(cmd *cobra.Command, args []string) error {
 }
 
 func runConfigSet(cmd *cobra.Command, args []string) error {
-	if configConsultant == "" && configClient == "" && configProject == "" && configRate == 0 && configLanguage == "" &&
+	if configConsultant == "" && configClient == "" && configProject == "" && configRate == "" && configLanguage == "" && configFiscalYear == 0 && configDailyHours == 0 &&
+		configInvPrefix == "" && configInvFirst == 0 && configInvDigits == 0 && configInvDueDays == 0 && !cmd.Flags().Changed("invoice-vat-rate") && configRounding == "" && !cmd.Flags().Changed("strict") &&
+		configSeller == (config.Seller{}) && len(configSellerAddr) == 0 &&
 		configDBHost == "" && configDBPort == "" && configDBUser == "" && configDBPassword == "" && configDBName == "" {
 		return fmt.Errorf("%s", i18n.T(i18n.KeyErrMustSpecifyValue))
 	}
 
-	if err := config.SaveDefaults(configConsultant, configClient, configProject, configRate, configLanguage); err != nil {
+	if configFiscalYear < 0 || configFiscalYear > 12 {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrMonthRange))
+	}
+	if configDailyHours < 0 || configDailyHours > 24 {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvalidDailyHours))
+	}
+	if configInvFirst < 0 || configInvDigits < 0 || configInvDueDays < 0 {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvalidInvoiceSettings))
+	}
+	if configInvVATRate < 0 || configInvVATRate > 100 {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvalidVATRate))
+	}
+	if configRounding != "" && configRounding != string(models.RoundPerLine) && configRounding != string(models.RoundPerTotal) {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvalidRounding))
+	}
+
+	var rate models.Money
+	if configRate != "" {
+		var err error
+		if rate, err = parseRate(configRate); err != nil {
+			return err
+		}
+	}
+
+	if err := config.SaveDefaults(configConsultant, configClient, configProject, rate.Float64(), configLanguage, configFiscalYear, configDailyHours); err != nil {
+		return err
+	}
+	// 0 is a valid VAT rate, so it is only saved when the flag was given
+	var vatRate *float64
+	if cmd.Flags().Changed("invoice-vat-rate") {
+		vatRate = &configInvVATRate
+	}
+	if err := config.SaveInvoiceSettings(configInvPrefix, configInvFirst, configInvDigits, configInvDueDays, vatRate); err != nil {
+		return err
+	}
+
+	if configRounding != "" {
+		if err := config.SaveRounding(configRounding); err != nil {
+			return err
+		}
+	}
+	// --strict=false turns strict mode off, so it is saved whenever the flag is given
+	if cmd.Flags().Changed("strict") {
+		if err := config.SaveStrict(configStrict); err != nil {
+			return err
+		}
+	}
+
+	// Each --seller-address flag is one line of the address
+	configSeller.Address = strings.Join(configSellerAddr, "\n")
+	if err := config.SaveSeller(configSeller); err != nil {
 		return err
 	}
 
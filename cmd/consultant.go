@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var consultantCmd = &cobra.Command{
+	Use:   "consultant",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+}
+
+func init() {
+	rootCmd.AddCommand(consultantCmd)
+}
+
+func localizeConsultantCommand() {
+	consultantCmd.Short = i18n.T(i18n.KeyConsultantShort)
+	consultantCmd.Long = i18n.T(i18n.KeyConsultantLong)
+
+	localizeConsultantListCommand()
+	localizeConsultantAddCommand()
+	localizeConsultantRenameCommand()
+	localizeConsultantArchiveCommand()
+	localizeConsultantShowCommand()
+	localizeConsultantSetCommand()
+}
+
+// consultantProfileFlags holds the profile flags shared by `consultant add` and `consultant set`
+type consultantProfileFlags struct {
+	email          string
+	employeeNumber string
+	rate           string
+	capacity       float64
+	company        string
+	subcontractor  bool
+}
+
+func addConsultantProfileFlags(cmd *cobra.Command, f *consultantProfileFlags) {
+	cmd.Flags().StringVar(&f.email, "email", "", "")
+	cmd.Flags().StringVar(&f.employeeNumber, "employee-number", "", "")
+	cmd.Flags().StringVarP(&f.rate, "rate", "r", "", "")
+	cmd.Flags().Float64Var(&f.capacity, "capacity", 0, "")
+	cmd.Flags().StringVar(&f.company, "company", "", "")
+	cmd.Flags().BoolVar(&f.subcontractor, "subcontractor", false, "")
+}
+
+func localizeConsultantProfileFlags(cmd *cobra.Command) {
+	cmd.Flags().Lookup("email").Usage = i18n.T(i18n.KeyConsultantFlagEmail)
+	cmd.Flags().Lookup("employee-number").Usage = i18n.T(i18n.KeyConsultantFlagEmployeeNumber)
+	cmd.Flags().Lookup("rate").Usage = i18n.T(i18n.KeyConsultantFlagRate)
+	cmd.Flags().Lookup("capacity").Usage = i18n.T(i18n.KeyConsultantFlagCapacity)
+	cmd.Flags().Lookup("company").Usage = i18n.T(i18n.KeyConsultantFlagCompany)
+	cmd.Flags().Lookup("subcontractor").Usage = i18n.T(i18n.KeyConsultantFlagSubcontractor)
+}
+
+// changed reports whether any profile flag was given
+func (f *consultantProfileFlags) changed(cmd *cobra.Command) bool {
+	for _, name := range []string{"email", "employee-number", "rate", "capacity", "company", "subcontractor"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply copies the given profile flags to the consultant. An empty text or a zero rate or
+// capacity clears the field.
+func (f *consultantProfileFlags) apply(cmd *cobra.Command, consultant *models.Consultant) error {
+	flags := cmd.Flags()
+	if flags.Changed("rate") {
+		rate, err := parseOptionalRate(f.rate)
+		if err != nil {
+			return err
+		}
+		consultant.DefaultRate = rate
+	}
+	if flags.Changed("capacity") {
+		if f.capacity < 0 || f.capacity > 168 {
+			return fmt.Errorf(i18n.T(i18n.KeyErrInvalidCapacity), f.capacity)
+		}
+		consultant.WeeklyCapacity = f.capacity
+	}
+	if flags.Changed("email") {
+		consultant.Email = strings.TrimSpace(f.email)
+	}
+	if flags.Changed("employee-number") {
+		consultant.EmployeeNumber = strings.TrimSpace(f.employeeNumber)
+	}
+	if flags.Changed("company") {
+		consultant.Company = strings.TrimSpace(f.company)
+	}
+	if flags.Changed("subcontractor") {
+		consultant.Subcontractor = f.subcontractor
+	}
+	return nil
+}
+
+// checkConsultantNameFree fails if name is taken by a consultant other than the one with
+// the given ID, also when the names differ only in case or surrounding spaces
+func checkConsultantNameFree(repo *database.Repository, name string, id uint) error {
+	existing, err := repo.GetConsultantByName(name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchConsultants), err)
+	}
+	if existing == nil {
+		existing, err = repo.FindSimilarConsultant(name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchConsultants), err)
+		}
+	}
+	if existing != nil && existing.ID != id {
+		return fmt.Errorf(i18n.T(i18n.KeyErrConsultantExists), existing.Name)
+	}
+	return nil
+}
+
+// loadConsultant returns the consultant with the given name, or an error if there is none
+func loadConsultant(repo *database.Repository, name string) (*models.Consultant, error) {
+	consultant, err := repo.GetConsultantByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchConsultants), err)
+	}
+	if consultant == nil {
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrConsultantNotFound), name)
+	}
+	return consultant, nil
+}
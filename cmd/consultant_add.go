@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var consultantAddProfile consultantProfileFlags
+
+var consultantAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConsultantAdd,
+}
+
+func init() {
+	consultantCmd.AddCommand(consultantAddCmd)
+
+	addConsultantProfileFlags(consultantAddCmd, &consultantAddProfile)
+}
+
+func localizeConsultantAddCommand() {
+	consultantAddCmd.Short = i18n.T(i18n.KeyConsultantAddShort)
+	consultantAddCmd.Long = i18n.T(i18n.KeyConsultantAddLong)
+
+	localizeConsultantProfileFlags(consultantAddCmd)
+}
+
+func runConsultantAdd(cmd *cobra.Command, args []string) error {
+	name := strings.TrimSpace(args[0])
+	if name == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrConsultantNameRequired))
+	}
+
+	consultant := &models.Consultant{Name: name, Active: true}
+	if err := consultantAddProfile.apply(cmd, consultant); err != nil {
+		return err
+	}
+
+	repo := database.NewRepository()
+	if err := checkConsultantNameFree(repo, name, 0); err != nil {
+		return err
+	}
+	if err := repo.CreateConsultant(consultant); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCreateConsultant), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyConsultantAddSuccess)+"\n", consultant.Name)
+
+	return nil
+}
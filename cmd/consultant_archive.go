@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var consultantArchiveUndo bool
+
+var consultantArchiveCmd = &cobra.Command{
+	Use:   "archive <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConsultantArchive,
+}
+
+func init() {
+	consultantCmd.AddCommand(consultantArchiveCmd)
+
+	consultantArchiveCmd.Flags().BoolVar(&consultantArchiveUndo, "undo", false, "")
+}
+
+func localizeConsultantArchiveCommand() {
+	consultantArchiveCmd.Short = i18n.T(i18n.KeyConsultantArchiveShort)
+	consultantArchiveCmd.Long = i18n.T(i18n.KeyConsultantArchiveLong)
+
+	consultantArchiveCmd.Flags().Lookup("undo").Usage = i18n.T(i18n.KeyConsultantArchiveFlagUndo)
+}
+
+func runConsultantArchive(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	consultant, err := loadConsultant(repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	consultant.Active = consultantArchiveUndo
+	if err := repo.UpdateConsultant(consultant); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateConsultant), err)
+	}
+
+	if consultantArchiveUndo {
+		fmt.Printf(i18n.T(i18n.KeyConsultantArchiveRestored)+"\n", consultant.Name)
+	} else {
+		fmt.Printf(i18n.T(i18n.KeyConsultantArchiveSuccess)+"\n", consultant.Name)
+	}
+
+	return nil
+}
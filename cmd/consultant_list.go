@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var consultantListAll bool
+
+var consultantListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runConsultantList,
+}
+
+func init() {
+	consultantCmd.AddCommand(consultantListCmd)
+
+	consultantListCmd.Flags().BoolVarP(&consultantListAll, "all", "a", false, "")
+}
+
+func localizeConsultantListCommand() {
+	consultantListCmd.Short = i18n.T(i18n.KeyConsultantListShort)
+	consultantListCmd.Long = i18n.T(i18n.KeyConsultantListLong)
+
+	consultantListCmd.Flags().Lookup("all").Usage = i18n.T(i18n.KeyConsultantListFlagAll)
+}
+
+func runConsultantList(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	all, err := repo.GetAllConsultants()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchConsultants), err)
+	}
+
+	var consultants []models.Consultant
+	for _, consultant := range all {
+		if consultant.Active || consultantListAll {
+			consultants = append(consultants, consultant)
+		}
+	}
+	if len(consultants) == 0 {
+		fmt.Println(i18n.T(i18n.KeyConsultantListNoResults))
+		return nil
+	}
+
+	activity, err := repo.ConsultantActivity()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	return output.FormatConsultants(consultants, activity, os.Stdout)
+}
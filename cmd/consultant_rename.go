@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var consultantRenameCmd = &cobra.Command{
+	Use:   "rename <name> <new name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConsultantRename,
+}
+
+func init() {
+	consultantCmd.AddCommand(consultantRenameCmd)
+}
+
+func localizeConsultantRenameCommand() {
+	consultantRenameCmd.Short = i18n.T(i18n.KeyConsultantRenameShort)
+	consultantRenameCmd.Long = i18n.T(i18n.KeyConsultantRenameLong)
+}
+
+func runConsultantRename(cmd *cobra.Command, args []string) error {
+	newName := strings.TrimSpace(args[1])
+	if newName == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrConsultantNameRequired))
+	}
+
+	repo := database.NewRepository()
+	consultant, err := loadConsultant(repo, args[0])
+	if err != nil {
+		return err
+	}
+	// Changing only the case of the name is allowed, but not taking the name of another consultant
+	if err := checkConsultantNameFree(repo, newName, consultant.ID); err != nil {
+		return err
+	}
+
+	oldName := consultant.Name
+	consultant.Name = newName
+	if err := repo.UpdateConsultant(consultant); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateConsultant), err)
+	}
+
+	// Keep the default consultant pointing at the renamed one
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+	if cfg.DefaultConsultant == oldName {
+		if err := config.SaveDefaults(newName, "", "", 0, "", 0, 0); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyConsultantRenameSuccess)+"\n", oldName, newName)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var consultantSetProfile consultantProfileFlags
+
+var consultantSetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConsultantSet,
+}
+
+func init() {
+	consultantCmd.AddCommand(consultantSetCmd)
+
+	addConsultantProfileFlags(consultantSetCmd, &consultantSetProfile)
+}
+
+func localizeConsultantSetCommand() {
+	consultantSetCmd.Short = i18n.T(i18n.KeyConsultantSetShort)
+	consultantSetCmd.Long = i18n.T(i18n.KeyConsultantSetLong)
+
+	localizeConsultantProfileFlags(consultantSetCmd)
+}
+
+func runConsultantSet(cmd *cobra.Command, args []string) error {
+	if !consultantSetProfile.changed(cmd) {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrConsultantNoChanges))
+	}
+
+	repo := database.NewRepository()
+	consultant, err := loadConsultant(repo, args[0])
+	if err != nil {
+		return err
+	}
+	if err := consultantSetProfile.apply(cmd, consultant); err != nil {
+		return err
+	}
+
+	if err := repo.UpdateConsultant(consultant); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateConsultant), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyConsultantSetSuccess)+"\n", consultant.Name)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var consultantShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConsultantShow,
+}
+
+func init() {
+	consultantCmd.AddCommand(consultantShowCmd)
+}
+
+func localizeConsultantShowCommand() {
+	consultantShowCmd.Short = i18n.T(i18n.KeyConsultantShowShort)
+	consultantShowCmd.Long = i18n.T(i18n.KeyConsultantShowLong)
+}
+
+func runConsultantShow(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	consultant, err := loadConsultant(repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	activity, err := repo.ConsultantActivity()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	return output.FormatConsultant(*consultant, activity[consultant.ID], os.Stdout)
+}
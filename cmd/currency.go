@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// roundingRule returns the configured rounding rule; anything but "line" rounds per total
+func roundingRule(cfg *config.Config) models.Rounding {
+	if cfg.Rounding == string(models.RoundPerLine) {
+		return models.RoundPerLine
+	}
+	return models.RoundPerTotal
+}
+
+// loadConverter returns a converter into the currency given on the command line, using
+// the configured exchange rate file. Without a currency, totals are kept per currency and
+// nil is returned.
+func loadConverter(cfg *config.Config, to string) (*currency.Converter, error) {
+	if to == "" {
+		return nil, nil
+	}
+	code, err := currency.Parse(to)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := currency.LoadRates(cfg.ExchangeRatesFile)
+	if err != nil {
+		return nil, err
+	}
+	return currency.NewConverter(rates, code)
+}
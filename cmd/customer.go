@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var customerCmd = &cobra.Command{
+	Use:   "customer",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+}
+
+func init() {
+	rootCmd.AddCommand(customerCmd)
+}
+
+func localizeCustomerCommand() {
+	customerCmd.Short = i18n.T(i18n.KeyCustomerShort)
+	customerCmd.Long = i18n.T(i18n.KeyCustomerLong)
+
+	localizeCustomerListCommand()
+	localizeCustomerAddCommand()
+	localizeCustomerRenameCommand()
+	localizeCustomerArchiveCommand()
+	localizeCustomerMergeCommand()
+	localizeCustomerShowCommand()
+	localizeCustomerSetCommand()
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var customerAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCustomerAdd,
+}
+
+func init() {
+	customerCmd.AddCommand(customerAddCmd)
+}
+
+func localizeCustomerAddCommand() {
+	customerAddCmd.Short = i18n.T(i18n.KeyCustomerAddShort)
+	customerAddCmd.Long = i18n.T(i18n.KeyCustomerAddLong)
+}
+
+func runCustomerAdd(cmd *cobra.Command, args []string) error {
+	name := strings.TrimSpace(args[0])
+	if name == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrCustomerNameRequired))
+	}
+
+	repo := database.NewRepository()
+	if err := checkCustomerNameFree(repo, name, 0); err != nil {
+		return err
+	}
+
+	customer := &models.Customer{Name: name, Active: true}
+	if err := repo.CreateCustomer(customer); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCreateCustomer), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyCustomerAddSuccess)+"\n", customer.Name)
+
+	return nil
+}
+
+// checkCustomerNameFree fails if name is taken by a customer other than the one with the
+// given ID, also when the names differ only in case or surrounding spaces
+func checkCustomerNameFree(repo *database.Repository, name string, id uint) error {
+	existing, err := repo.GetCustomerByName(name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchCustomers), err)
+	}
+	if existing == nil {
+		existing, err = repo.FindSimilarCustomer(name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchCustomers), err)
+		}
+	}
+	if existing != nil && existing.ID != id {
+		return fmt.Errorf(i18n.T(i18n.KeyErrCustomerExists), existing.Name)
+	}
+	return nil
+}
+
+// loadCustomer returns the customer with the given name, or an error if there is none
+func loadCustomer(repo *database.Repository, name string) (*models.Customer, error) {
+	customer, err := repo.GetCustomerByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchCustomers), err)
+	}
+	if customer == nil {
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrCustomerNotFound), name)
+	}
+	return customer, nil
+}
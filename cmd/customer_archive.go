@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var customerArchiveUndo bool
+
+var customerArchiveCmd = &cobra.Command{
+	Use:   "archive <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCustomerArchive,
+}
+
+func init() {
+	customerCmd.AddCommand(customerArchiveCmd)
+
+	customerArchiveCmd.Flags().BoolVar(&customerArchiveUndo, "undo", false, "")
+}
+
+func localizeCustomerArchiveCommand() {
+	customerArchiveCmd.Short = i18n.T(i18n.KeyCustomerArchiveShort)
+	customerArchiveCmd.Long = i18n.T(i18n.KeyCustomerArchiveLong)
+
+	customerArchiveCmd.Flags().Lookup("undo").Usage = i18n.T(i18n.KeyCustomerArchiveFlagUndo)
+}
+
+func runCustomerArchive(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	customer, err := loadCustomer(repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	customer.Active = customerArchiveUndo
+	if err := repo.UpdateCustomer(customer); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateCustomer), err)
+	}
+
+	if customerArchiveUndo {
+		fmt.Printf(i18n.T(i18n.KeyCustomerArchiveRestored)+"\n", customer.Name)
+	} else {
+		fmt.Printf(i18n.T(i18n.KeyCustomerArchiveSuccess)+"\n", customer.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var customerListAll bool
+
+var customerListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runCustomerList,
+}
+
+func init() {
+	customerCmd.AddCommand(customerListCmd)
+
+	customerListCmd.Flags().BoolVarP(&customerListAll, "all", "a", false, "")
+}
+
+func localizeCustomerListCommand() {
+	customerListCmd.Short = i18n.T(i18n.KeyCustomerListShort)
+	customerListCmd.Long = i18n.T(i18n.KeyCustomerListLong)
+
+	customerListCmd.Flags().Lookup("all").Usage = i18n.T(i18n.KeyCustomerListFlagAll)
+}
+
+func runCustomerList(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+
+	repo := database.NewRepository()
+	all, err := repo.GetAllCustomers()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchCustomers), err)
+	}
+
+	var customers []models.Customer
+	for _, customer := range all {
+		if customer.Active || customerListAll {
+			customers = append(customers, customer)
+		}
+	}
+	if len(customers) == 0 {
+		fmt.Println(i18n.T(i18n.KeyCustomerListNoResults))
+		return nil
+	}
+
+	activity, err := repo.CustomerActivity()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	return output.FormatCustomers(customers, activity, cfg.Invoice.VATRate, os.Stdout)
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var customerMergeYes bool
+
+var customerMergeCmd = &cobra.Command{
+	Use:   "merge <name> <into>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(2),
+	RunE:  runCustomerMerge,
+}
+
+func init() {
+	customerCmd.AddCommand(customerMergeCmd)
+
+	customerMergeCmd.Flags().BoolVar(&customerMergeYes, "yes", false, "")
+}
+
+func localizeCustomerMergeCommand() {
+	customerMergeCmd.Short = i18n.T(i18n.KeyCustomerMergeShort)
+	customerMergeCmd.Long = i18n.T(i18n.KeyCustomerMergeLong)
+
+	customerMergeCmd.Flags().Lookup("yes").Usage = i18n.T(i18n.KeyCustomerMergeFlagYes)
+}
+
+func runCustomerMerge(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	from, err := loadCustomer(repo, args[0])
+	if err != nil {
+		return err
+	}
+	into, err := loadCustomer(repo, args[1])
+	if err != nil {
+		return err
+	}
+	if from.ID == into.ID {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrMergeSameCustomer))
+	}
+	// The rates of the work logs that move along are amounts in the currency of from
+	if from.CurrencyCode() != into.CurrencyCode() {
+		return fmt.Errorf(i18n.T(i18n.KeyErrMergeCurrency), from.Name, into.Name, from.Name, from.CurrencyCode(), into.Name, into.CurrencyCode())
+	}
+
+	if !customerMergeYes {
+		ok, err := confirm(fmt.Sprintf(i18n.T(i18n.KeyCustomerMergeConfirm), from.Name, into.Name, from.Name))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println(i18n.T(i18n.KeyCustomerMergeAborted))
+			return nil
+		}
+	}
+
+	result, err := repo.MergeCustomers(from, into)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrMergeCustomers), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyCustomerMergeSuccess)+"\n", from.Name, into.Name, result.ProjectsMoved, result.ProjectsMerged, result.EntriesMerged)
+	if len(result.DroppedRateCards) > 0 {
+		fmt.Println()
+		fmt.Printf(i18n.T(i18n.KeyCustomerMergeDroppedRateCards)+"\n", into.Name)
+		if err := output.FormatRateCards(result.DroppedRateCards, os.Stdout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var customerRenameCmd = &cobra.Command{
+	Use:   "rename <name> <new name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(2),
+	RunE:  runCustomerRename,
+}
+
+func init() {
+	customerCmd.AddCommand(customerRenameCmd)
+}
+
+func localizeCustomerRenameCommand() {
+	customerRenameCmd.Short = i18n.T(i18n.KeyCustomerRenameShort)
+	customerRenameCmd.Long = i18n.T(i18n.KeyCustomerRenameLong)
+}
+
+func runCustomerRename(cmd *cobra.Command, args []string) error {
+	newName := strings.TrimSpace(args[1])
+	if newName == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrCustomerNameRequired))
+	}
+
+	repo := database.NewRepository()
+	customer, err := loadCustomer(repo, args[0])
+	if err != nil {
+		return err
+	}
+	// Changing only the case of the name is allowed, but not taking the name of another customer
+	if err := checkCustomerNameFree(repo, newName, customer.ID); err != nil {
+		return err
+	}
+
+	oldName := customer.Name
+	customer.Name = newName
+	if err := repo.UpdateCustomer(customer); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateCustomer), err)
+	}
+
+	// Keep the default customer pointing at the renamed one
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+	if cfg.DefaultClient == oldName {
+		if err := config.SaveDefaults("", newName, "", 0, "", 0, 0); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyCustomerRenameSuccess)+"\n", oldName, newName)
+
+	return nil
+}
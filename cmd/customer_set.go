@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	customerSetCurrency      string
+	customerSetVATRate       string
+	customerSetReverseCharge bool
+	customerSetVATNumber     string
+	customerSetLanguage      string
+	customerSetKeepRates     bool
+)
+
+var customerSetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCustomerSet,
+}
+
+func init() {
+	customerCmd.AddCommand(customerSetCmd)
+
+	customerSetCmd.Flags().StringVar(&customerSetCurrency, "currency", "", "")
+	customerSetCmd.Flags().StringVar(&customerSetVATRate, "vat-rate", "", "")
+	customerSetCmd.Flags().BoolVar(&customerSetReverseCharge, "reverse-charge", false, "")
+	customerSetCmd.Flags().StringVar(&customerSetVATNumber, "vat-number", "", "")
+	customerSetCmd.Flags().StringVarP(&customerSetLanguage, "language", "l", "", "")
+	customerSetCmd.Flags().BoolVar(&customerSetKeepRates, "keep-rates", false, "")
+}
+
+func localizeCustomerSetCommand() {
+	customerSetCmd.Short = i18n.T(i18n.KeyCustomerSetShort)
+	customerSetCmd.Long = i18n.T(i18n.KeyCustomerSetLong)
+
+	customerSetCmd.Flags().Lookup("currency").Usage = i18n.T(i18n.KeyCustomerSetFlagCurrency)
+	customerSetCmd.Flags().Lookup("vat-rate").Usage = i18n.T(i18n.KeyCustomerSetFlagVATRate)
+	customerSetCmd.Flags().Lookup("reverse-charge").Usage = i18n.T(i18n.KeyCustomerSetFlagReverseCharge)
+	customerSetCmd.Flags().Lookup("vat-number").Usage = i18n.T(i18n.KeyCustomerSetFlagVATNumber)
+	customerSetCmd.Flags().Lookup("language").Usage = i18n.T(i18n.KeyCustomerSetFlagLanguage)
+	customerSetCmd.Flags().Lookup("keep-rates").Usage = i18n.T(i18n.KeyCustomerSetFlagKeepRates)
+}
+
+func runCustomerSet(cmd *cobra.Command, args []string) error {
+	flags := cmd.Flags()
+	if !flags.Changed("currency") && !flags.Changed("vat-rate") && !flags.Changed("reverse-charge") && !flags.Changed("vat-number") && !flags.Changed("language") {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrCustomerNoChanges))
+	}
+
+	repo := database.NewRepository()
+	var reinterpreted []models.RateCard
+	customer, err := repo.GetCustomerByName(args[0])
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateCustomer), err)
+	}
+	if customer == nil {
+		return fmt.Errorf(i18n.T(i18n.KeyErrCustomerNotFound), args[0])
+	}
+
+	if flags.Changed("currency") {
+		code, err := currency.Parse(customerSetCurrency)
+		if err != nil {
+			return err
+		}
+		// The rates of existing work logs are amounts in the current currency and are not converted
+		if code != customer.CurrencyCode() && !customerSetKeepRates {
+			entries, err := repo.CountCustomerTimeEntries(customer.ID)
+			if err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+			}
+			if entries > 0 {
+				return fmt.Errorf(i18n.T(i18n.KeyErrCustomerCurrencyInUse), customer.Name, entries, customer.CurrencyCode(), code)
+			}
+		}
+		// Neither are the rate cards of the customer and its projects, which are listed afterwards
+		if code != customer.CurrencyCode() {
+			if reinterpreted, err = repo.GetRateCards("", customer.Name, "", time.Time{}); err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchRateCards), err)
+			}
+		}
+		customer.Currency = code
+	}
+	if flags.Changed("vat-rate") {
+		// "default" clears the rate of the customer, so it follows the configured one
+		if strings.EqualFold(customerSetVATRate, "default") {
+			customer.VATRate = nil
+		} else {
+			rate, err := strconv.ParseFloat(customerSetVATRate, 64)
+			if err != nil || rate < 0 || rate > 100 {
+				return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvalidVATRate))
+			}
+			customer.VATRate = &rate
+		}
+	}
+	if flags.Changed("reverse-charge") {
+		customer.ReverseCharge = customerSetReverseCharge
+	}
+	if flags.Changed("vat-number") {
+		customer.VATNumber = strings.TrimSpace(customerSetVATNumber)
+	}
+	if flags.Changed("language") {
+		// An empty language clears it, so invoices follow the language of the user
+		lang := strings.ToLower(strings.TrimSpace(customerSetLanguage))
+		if lang != "" && i18n.Normalize(lang) != lang {
+			return fmt.Errorf(i18n.T(i18n.KeyErrInvalidLanguage), customerSetLanguage)
+		}
+		customer.Language = lang
+	}
+
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+
+	if err := repo.UpdateCustomer(customer); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateCustomer), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyCustomerSetSuccess)+"\n", customer.Name, customer.CurrencyCode(), describeCustomerVAT(customer, cfg.Invoice.VATRate), orDefault(customer.Language))
+	if len(reinterpreted) > 0 {
+		fmt.Println()
+		fmt.Printf(i18n.T(i18n.KeyCustomerSetReinterpretedRateCards)+"\n", customer.CurrencyCode())
+		if err := output.FormatRateCards(reinterpreted, os.Stdout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// describeCustomerVAT describes the VAT charged to a customer, e.g. "VAT 25% (default)"
+func describeCustomerVAT(customer *models.Customer, defaultRate float64) string {
+	label := output.VATLabel(customer.EffectiveVATRate(defaultRate))
+	switch {
+	case customer.ReverseCharge:
+		return label + " (" + i18n.T(i18n.KeyVATReverseCharge) + ")"
+	case customer.VATRate == nil:
+		return label + " (" + i18n.T(i18n.KeyVATDefault) + ")"
+	default:
+		return label
+	}
+}
+
+// orDefault returns value, or the translated word for the default when it is empty
+func orDefault(value string) string {
+	if value == "" {
+		return i18n.T(i18n.KeyVATDefault)
+	}
+	return value
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var customerShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCustomerShow,
+}
+
+func init() {
+	customerCmd.AddCommand(customerShowCmd)
+}
+
+func localizeCustomerShowCommand() {
+	customerShowCmd.Short = i18n.T(i18n.KeyCustomerShowShort)
+	customerShowCmd.Long = i18n.T(i18n.KeyCustomerShowLong)
+}
+
+func runCustomerShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+
+	repo := database.NewRepository()
+	customer, err := loadCustomer(repo, args[0])
+	if err != nil {
+		return err
+	}
+	// Load the projects with the customer
+	customer, err = repo.GetCustomerByID(customer.ID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchCustomers), err)
+	}
+
+	activity, err := repo.CustomerActivity()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	return output.FormatCustomer(*customer, activity[customer.ID], cfg.Invoice.VATRate, os.Stdout)
+}
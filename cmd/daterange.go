@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/period"
+	"github.com/spf13/cobra"
+)
+
+// dateRangeFlags holds the date filter flags shared by commands that select entries by period
+type dateRangeFlags struct {
+	date       string
+	from       string
+	to         string
+	today      bool
+	week       int
+	month      int
+	year       int
+	quarter    string
+	fiscalYear int
+	last       string
+}
+
+func addDateRangeFlags(cmd *cobra.Command, f *dateRangeFlags) {
+	cmd.Flags().IntVarP(&f.month, "month", "m", 0, "")
+	cmd.Flags().StringVar(&f.from, "from", "", "")
+	cmd.Flags().StringVar(&f.to, "to", "", "")
+	cmd.Flags().StringVarP(&f.date, "date", "D", "", "")
+	cmd.Flags().BoolVar(&f.today, "today", false, "")
+	cmd.Flags().IntVarP(&f.week, "week", "w", 0, "")
+	cmd.Flags().IntVarP(&f.year, "year", "y", 0, "")
+	cmd.Flags().StringVarP(&f.quarter, "quarter", "q", "", "")
+	cmd.Flags().IntVar(&f.fiscalYear, "fiscal-year", 0, "")
+	cmd.Flags().StringVar(&f.last, "last", "", "")
+}
+
+func localizeDateRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().Lookup("month").Usage = i18n.T(i18n.KeyGetFlagMonth)
+	cmd.Flags().Lookup("from").Usage = i18n.T(i18n.KeyGetFlagFromDate)
+	cmd.Flags().Lookup("to").Usage = i18n.T(i18n.KeyGetFlagToDate)
+	cmd.Flags().Lookup("date").Usage = i18n.T(i18n.KeyGetFlagDate)
+	cmd.Flags().Lookup("today").Usage = i18n.T(i18n.KeyGetFlagToday)
+	cmd.Flags().Lookup("week").Usage = i18n.T(i18n.KeyGetFlagWeek)
+	cmd.Flags().Lookup("year").Usage = i18n.T(i18n.KeyGetFlagYear)
+	cmd.Flags().Lookup("quarter").Usage = i18n.T(i18n.KeyGetFlagQuarter)
+	cmd.Flags().Lookup("fiscal-year").Usage = i18n.T(i18n.KeyGetFlagFiscalYear)
+	cmd.Flags().Lookup("last").Usage = i18n.T(i18n.KeyGetFlagLast)
+}
+
+// spec converts the flags to a period selection
+func (f *dateRangeFlags) spec() period.Spec {
+	s := period.Spec{
+		Date:       f.date,
+		From:       f.from,
+		To:         f.to,
+		Week:       f.week,
+		Month:      f.month,
+		Year:       f.year,
+		Quarter:    f.quarter,
+		FiscalYear: f.fiscalYear,
+		Last:       f.last,
+	}
+	if f.today {
+		s.Date = time.Now().Format("2006-01-02")
+	}
+	return s
+}
+
+// isSet reports whether any date filter was given
+func (f *dateRangeFlags) isSet() bool {
+	return f.spec().IsSet()
+}
+
+// resolve returns the half-open range [startDate, endDate) selected by the flags.
+// Without any date filter the current month is selected.
+func (f *dateRangeFlags) resolve() (startDate, endDate time.Time, err error) {
+	cfg, err := config.Get()
+	if err != nil {
+		return startDate, endDate, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+
+	s := f.spec()
+	s.FiscalYearStartMonth = time.Month(cfg.FiscalYearStartMonth)
+
+	return s.Resolve(time.Now())
+}
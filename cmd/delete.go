@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	deleteConsultant string
+	deleteProject    string
+	deleteCustomer   string
+	deleteDates      dateRangeFlags
+	deleteYes        bool
+	deleteDryRun     bool
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete [id...]",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	RunE:  runDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(deleteCmd)
+
+	deleteCmd.Flags().StringVarP(&deleteConsultant, "consultant", "n", "", "")
+	deleteCmd.Flags().StringVarP(&deleteProject, "project", "p", "", "")
+	deleteCmd.Flags().StringVarP(&deleteCustomer, "customer", "c", "", "")
+	addDateRangeFlags(deleteCmd, &deleteDates)
+	deleteCmd.Flags().BoolVar(&deleteYes, "yes", false, "")
+	deleteCmd.Flags().BoolVar(&deleteDryRun, "dry-run", false, "")
+}
+
+func localizeDeleteCommand() {
+	deleteCmd.Short = i18n.T(i18n.KeyDeleteShort)
+	deleteCmd.Long = i18n.T(i18n.KeyDeleteLong)
+
+	deleteCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyGetFlagConsultant)
+	deleteCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyGetFlagProject)
+	deleteCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyGetFlagCustomer)
+	localizeDateRangeFlags(deleteCmd)
+	deleteCmd.Flags().Lookup("yes").Usage = i18n.T(i18n.KeyDeleteFlagYes)
+	deleteCmd.Flags().Lookup("dry-run").Usage = i18n.T(i18n.KeyDeleteFlagDryRun)
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	hasFilters := deleteConsultant != "" || deleteProject != "" || deleteCustomer != "" || deleteDates.isSet()
+	if len(args) > 0 && hasFilters {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrDeleteIDsAndFilters))
+	}
+	if len(args) == 0 && !hasFilters {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrDeleteNothingSelected))
+	}
+
+	repo := database.NewRepository()
+
+	var entries []models.TimeEntry
+	if len(args) > 0 {
+		// Explicit IDs: every one of them must exist
+		seen := make(map[uint]bool)
+		for _, arg := range args {
+			entry, err := loadTimeEntry(repo, arg)
+			if err != nil {
+				return err
+			}
+			if seen[entry.ID] {
+				continue
+			}
+			seen[entry.ID] = true
+			entries = append(entries, *entry)
+		}
+	} else {
+		// Filter mode: select the same entries as `get` with the same flags would show
+		startDate, endDate, err := deleteDates.resolve()
+		if err != nil {
+			return err
+		}
+
+		entries, err = repo.GetTimeEntriesByFilters(deleteConsultant, deleteProject, deleteCustomer, startDate, endDate)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+		}
+	}
+
+	if len(entries) == 0 {
+		fmt.Println(i18n.T(i18n.KeyGetNoResults))
+		return nil
+	}
+
+	// Invoiced entries are locked, so nothing is deleted if any of them is selected
+	for i := range entries {
+		if err := checkNotInvoiced(repo, &entries[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := output.GetFormatter(output.FormatTable, output.Options{ShowIDs: true}).Format(entries, os.Stdout); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if deleteDryRun {
+		fmt.Printf(i18n.T(i18n.KeyDeleteDryRun)+"\n", len(entries))
+		return nil
+	}
+
+	if !deleteYes {
+		ok, err := confirm(fmt.Sprintf(i18n.T(i18n.KeyDeleteConfirm), len(entries)))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println(i18n.T(i18n.KeyDeleteAborted))
+			return nil
+		}
+	}
+
+	ids := make([]uint, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+
+	if err := repo.DeleteTimeEntries(ids); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrDeleteWorkLogs), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyDeleteSuccess)+"\n", len(entries))
+
+	return nil
+}
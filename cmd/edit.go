@@ -0,0 +1,270 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/dateparse"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	editHours         string
+	editDescription   string
+	editProject       string
+	editClient        string
+	editConsultant    string
+	editRate          string
+	editDate          string
+	editMerge         bool
+	editAllowInactive bool
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runEdit,
+}
+
+func init() {
+	rootCmd.AddCommand(editCmd)
+
+	editCmd.Flags().StringVarP(&editHours, "hours", "t", "", "")
+	editCmd.Flags().StringVarP(&editDescription, "description", "d", "", "")
+	editCmd.Flags().StringVarP(&editProject, "project", "p", "", "")
+	editCmd.Flags().StringVarP(&editClient, "client", "c", "", "")
+	editCmd.Flags().StringVarP(&editConsultant, "consultant", "n", "", "")
+	editCmd.Flags().StringVarP(&editRate, "rate", "r", "", "")
+	editCmd.Flags().StringVarP(&editDate, "date", "D", "", "")
+	editCmd.Flags().BoolVar(&editMerge, "merge", false, "")
+	editCmd.Flags().BoolVar(&editAllowInactive, "allow-inactive", false, "")
+}
+
+func localizeEditCommand() {
+	editCmd.Short = i18n.T(i18n.KeyEditShort)
+	editCmd.Long = i18n.T(i18n.KeyEditLong)
+
+	editCmd.Flags().Lookup("hours").Usage = i18n.T(i18n.KeyEditFlagHours)
+	editCmd.Flags().Lookup("description").Usage = i18n.T(i18n.KeyEditFlagDescription)
+	editCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyEditFlagProject)
+	editCmd.Flags().Lookup("client").Usage = i18n.T(i18n.KeyEditFlagClient)
+	editCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyEditFlagConsultant)
+	editCmd.Flags().Lookup("rate").Usage = i18n.T(i18n.KeyEditFlagRate)
+	editCmd.Flags().Lookup("date").Usage = i18n.T(i18n.KeyEditFlagDate)
+	editCmd.Flags().Lookup("merge").Usage = i18n.T(i18n.KeyEditFlagMerge)
+	editCmd.Flags().Lookup("allow-inactive").Usage = i18n.T(i18n.KeyEditFlagAllowInactive)
+}
+
+func runEdit(cmd *cobra.Command, args []string) error {
+	flags := cmd.Flags()
+	if !flags.Changed("hours") && !flags.Changed("description") && !flags.Changed("project") &&
+		!flags.Changed("client") && !flags.Changed("consultant") && !flags.Changed("rate") && !flags.Changed("date") {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrMustSpecifyValue))
+	}
+
+	repo := database.NewRepository()
+
+	entry, err := loadTimeEntry(repo, args[0])
+	if err != nil {
+		return err
+	}
+	if err := checkNotInvoiced(repo, entry); err != nil {
+		return err
+	}
+	before := *entry
+
+	if flags.Changed("hours") {
+		newHours, err := parseHours(editHours)
+		if err != nil {
+			return err
+		}
+
+		// Start and break are kept, so the end time moves by the change in hours. Times are
+		// stored as UTC on the date of the entry, so the end has to stay on that date in UTC.
+		if entry.HasTimes() {
+			shift := time.Duration((newHours - entry.Hours) * float64(time.Hour))
+			end := entry.EndTime.Add(shift).Round(time.Minute)
+			if end.In(time.UTC).Format("2006-01-02") != entry.Date.Format("2006-01-02") || !end.After(*entry.StartTime) {
+				return fmt.Errorf(i18n.T(i18n.KeyErrEditEndPastMidnight), newHours, entry.StartTime.Format("15:04"))
+			}
+			entry.EndTime = &end
+		}
+		entry.Hours = newHours
+	}
+	if flags.Changed("description") {
+		entry.Description = editDescription
+	}
+	if flags.Changed("rate") {
+		rate, err := parseRate(editRate)
+		if err != nil {
+			return err
+		}
+		entry.HourlyRate = rate
+	}
+	if flags.Changed("date") {
+		newDate, err := dateparse.Parse(editDate, time.Now())
+		if err != nil {
+			return err
+		}
+
+		// Start and end times move along with the date
+		if entry.HasTimes() {
+			shift := newDate.Sub(entry.Date)
+			start, end := entry.StartTime.Add(shift), entry.EndTime.Add(shift)
+			entry.StartTime, entry.EndTime = &start, &end
+		}
+		entry.Date = newDate
+	}
+
+	// Re-resolve the consultant
+	if flags.Changed("consultant") {
+		if editConsultant == "" {
+			return fmt.Errorf("%s", i18n.T(i18n.KeyErrConsultantRequired))
+		}
+		consultantObj, err := repo.GetOrCreateConsultant(editConsultant)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateConsultant), err)
+		}
+		if !editAllowInactive {
+			if err := checkConsultantActive(consultantObj); err != nil {
+				return err
+			}
+		}
+		entry.ConsultantID = consultantObj.ID
+		entry.Consultant = *consultantObj
+	}
+
+	// Re-resolve the project, keeping the current customer or project name when only one of them changes
+	if flags.Changed("client") || flags.Changed("project") {
+		customerName := entry.Project.Customer.Name
+		if flags.Changed("client") {
+			customerName = strings.TrimSpace(editClient)
+		}
+		projectName := entry.Project.Name
+		if flags.Changed("project") {
+			projectName = strings.TrimSpace(editProject)
+		}
+		if customerName == "" {
+			return fmt.Errorf("%s", i18n.T(i18n.KeyErrCustomerRequired))
+		}
+		if projectName == "" {
+			return fmt.Errorf("%s", i18n.T(i18n.KeyErrProjectRequired))
+		}
+
+		// A project code also moves the entry to the customer of that project
+		projectObj, err := findProject(repo, projectName, customerName)
+		if err != nil {
+			return err
+		}
+		if projectObj == nil {
+			cfg, err := config.Get()
+			if err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+			}
+			customerObj, err := customerFor(repo, customerName, cfg.Strict)
+			if err != nil {
+				return err
+			}
+			if !editAllowInactive {
+				if err := checkCustomerActive(customerObj); err != nil {
+					return err
+				}
+			}
+			projectObj, err = repo.GetOrCreateProject(projectName, customerObj.ID)
+			if err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateProject), err)
+			}
+			projectObj.Customer = *customerObj
+		} else if !editAllowInactive {
+			if err := checkProjectActive(projectObj); err != nil {
+				return err
+			}
+		}
+		entry.ProjectID = projectObj.ID
+		entry.Project = *projectObj
+	}
+
+	changes := diffTimeEntries(&before, entry)
+	if len(changes) == 0 {
+		fmt.Printf(i18n.T(i18n.KeyEditNoChanges)+"\n", entry.ID)
+		return nil
+	}
+
+	// Refuse to create a second entry with the same merge key as an existing one.
+	// Entries with start and end times are never merged.
+	var conflict *models.TimeEntry
+	if !entry.HasTimes() {
+		conflict, err = repo.FindConflictingTimeEntry(entry)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCheckExistingEntry), err)
+		}
+	}
+	if conflict != nil && !editMerge {
+		return fmt.Errorf(i18n.T(i18n.KeyErrEditConflict), conflict.ID)
+	}
+
+	if conflict != nil {
+		// Fold the edited entry into the conflicting one
+		err = repo.Transaction(func(tx *database.Repository) error {
+			if err := tx.UpdateTimeEntryHours(conflict.ID, entry.Hours); err != nil {
+				return err
+			}
+			return tx.DeleteTimeEntry(entry.ID)
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateWorkLog), err)
+		}
+		fmt.Printf(i18n.T(i18n.KeyEditSuccessMerged)+"\n", entry.ID, conflict.ID)
+	} else {
+		if err := repo.UpdateTimeEntry(entry); err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateWorkLog), err)
+		}
+		fmt.Printf(i18n.T(i18n.KeyEditSuccess)+"\n", entry.ID)
+	}
+
+	for _, change := range changes {
+		fmt.Printf(i18n.T(i18n.KeyEditDiffLine)+"\n", change.label, change.before, change.after)
+	}
+
+	if conflict == nil {
+		return warnOverlaps(repo, entry)
+	}
+	return nil
+}
+
+type fieldChange struct {
+	label  string
+	before string
+	after  string
+}
+
+// diffTimeEntries lists the user-visible fields that differ between two versions of an entry
+func diffTimeEntries(before, after *models.TimeEntry) []fieldChange {
+	fields := []fieldChange{
+		{i18n.T(i18n.KeyFieldDate), before.Date.Format("2006-01-02"), after.Date.Format("2006-01-02")},
+		{i18n.T(i18n.KeyFieldConsultant), before.Consultant.Name, after.Consultant.Name},
+		{i18n.T(i18n.KeyFieldHours), fmt.Sprintf("%.2f", before.Hours), fmt.Sprintf("%.2f", after.Hours)},
+		{i18n.T(i18n.KeyFieldStart), output.FormatClock(before.StartTime), output.FormatClock(after.StartTime)},
+		{i18n.T(i18n.KeyFieldEnd), output.FormatClock(before.EndTime), output.FormatClock(after.EndTime)},
+		{i18n.T(i18n.KeyFieldRate), before.HourlyRate.String(), after.HourlyRate.String()},
+		{i18n.T(i18n.KeyFieldProject), before.Project.Name, after.Project.Name},
+		{i18n.T(i18n.KeyFieldCustomer), before.Project.Customer.Name, after.Project.Customer.Name},
+		{i18n.T(i18n.KeyFieldDescription), before.Description, after.Description},
+	}
+
+	var changes []fieldChange
+	for _, field := range fields {
+		if field.before != field.after {
+			changes = append(changes, field)
+		}
+	}
+	return changes
+}
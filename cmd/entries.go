@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/duration"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// parseEntryID parses a time entry ID given on the command line
+func parseEntryID(arg string) (uint, error) {
+	id, err := strconv.ParseUint(arg, 10, 0)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("%s: %q", i18n.T(i18n.KeyErrInvalidEntryID), arg)
+	}
+	return uint(id), nil
+}
+
+// loadTimeEntry fetches the time entry identified by arg, failing if it does not exist
+func loadTimeEntry(repo *database.Repository, arg string) (*models.TimeEntry, error) {
+	id, err := parseEntryID(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := repo.GetTimeEntryByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLog), err)
+	}
+	if entry == nil {
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrEntryNotFound), id)
+	}
+
+	return entry, nil
+}
+
+// checkNotInvoiced fails if the entry is on an invoice, since invoiced entries are locked
+func checkNotInvoiced(repo *database.Repository, entry *models.TimeEntry) error {
+	if !entry.IsInvoiced() {
+		return nil
+	}
+
+	invoice, err := repo.GetInvoiceByID(*entry.InvoiceID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchInvoice), err)
+	}
+	number := strconv.FormatUint(uint64(*entry.InvoiceID), 10)
+	if invoice != nil {
+		number = invoice.Number
+	}
+
+	return fmt.Errorf(i18n.T(i18n.KeyErrEntryInvoiced), entry.ID, number)
+}
+
+// parseHours parses a --hours value, which must be a positive duration
+func parseHours(value string) (float64, error) {
+	hours, err := duration.ParseHours(value)
+	if err != nil {
+		return 0, err
+	}
+	if hours <= 0 {
+		return 0, fmt.Errorf("%s", i18n.T(i18n.KeyErrHoursMustBePositive))
+	}
+	return hours, nil
+}
+
+// printParsedHours echoes how a --hours value was read, unless it was already plain decimal hours
+func printParsedHours(value string, hours float64) {
+	if strings.TrimSpace(value) != duration.Format(hours) {
+		fmt.Printf(i18n.T(i18n.KeyAddOutputHoursParsed)+"\n", hours, value)
+	}
+}
+
+// parseClock parses a time of day given as HH:MM and places it on date
+func parseClock(date time.Time, value string) (time.Time, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(i18n.T(i18n.KeyErrInvalidTimeOfDay), value)
+	}
+	return clockOn(date, t), nil
+}
+
+// clockOn places the clock time of t on date. Times of day are stored as UTC on the date of
+// the entry, so they read the same in every time zone.
+func clockOn(date, t time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC)
+}
+
+// warnOverlaps prints a warning for every other entry of the same consultant that overlaps entry in time
+func warnOverlaps(repo *database.Repository, entry *models.TimeEntry) error {
+	overlaps, err := repo.FindOverlappingTimeEntries(entry)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFindOverlapping), err)
+	}
+
+	for _, other := range overlaps {
+		fmt.Printf(i18n.T(i18n.KeyAddWarningOverlap)+"\n", other.ID, other.Date.Format("2006-01-02"),
+			other.StartTime.Format("15:04"), other.EndTime.Format("15:04"), other.Description)
+	}
+	return nil
+}
+
+// confirm asks the user a yes/no question on stdin, defaulting to no
+func confirm(prompt string) (bool, error) {
+	fmt.Print(prompt + " ")
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	for _, yes := range strings.Split(i18n.T(i18n.KeyConfirmYes), ",") {
+		if answer == yes {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -3,10 +3,12 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"time"
+	"strings"
 
+	"github.com/LimerDev/worklog/internal/config"
 	"github.com/LimerDev/worklog/internal/database"
 	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
 	"github.com/LimerDev/worklog/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -15,15 +17,14 @@ var (
 	getConsultant string
 	getProject    string
 	getCustomer   string
-	getMonth      int
-	getFromDate   string
-	getToDate     string
-	getDate       string
-	getToday      bool
-	getWeek       int
-	getYear       int
+	getDates      dateRangeFlags
 	getOutput     string
 	getOutputFile string
+	getShowIDs    bool
+	getSummary    bool
+	getGroupBy    string
+	getCurrency   string
+	getArchived   string
 )
 
 var getCmd = &cobra.Command{
@@ -39,99 +40,44 @@ func init() {
 	getCmd.Flags().StringVarP(&getConsultant, "consultant", "n", "", "")
 	getCmd.Flags().StringVarP(&getProject, "project", "p", "", "")
 	getCmd.Flags().StringVarP(&getCustomer, "customer", "c", "", "")
-	getCmd.Flags().IntVarP(&getMonth, "month", "m", 0, "")
-	getCmd.Flags().StringVar(&getFromDate, "from", "", "")
-	getCmd.Flags().StringVar(&getToDate, "to", "", "")
-	getCmd.Flags().StringVarP(&getDate, "date", "D", "", "")
-	getCmd.Flags().BoolVar(&getToday, "today", false, "")
-	getCmd.Flags().IntVarP(&getWeek, "week", "w", 0, "")
-	getCmd.Flags().IntVarP(&getYear, "year", "y", 0, "")
+	addDateRangeFlags(getCmd, &getDates)
 	getCmd.Flags().StringVarP(&getOutput, "output", "o", "table", "")
 	getCmd.Flags().StringVar(&getOutputFile, "output-file", "", "")
+	getCmd.Flags().BoolVarP(&getShowIDs, "ids", "i", false, "")
+	getCmd.Flags().BoolVarP(&getSummary, "summary", "s", false, "")
+	getCmd.Flags().StringVarP(&getGroupBy, "group-by", "g", "", "")
+	getCmd.Flags().StringVar(&getCurrency, "currency", "", "")
+	getCmd.Flags().StringVar(&getArchived, "archived", string(archivedInclude), "")
 }
 
 func runGet(cmd *cobra.Command, args []string) error {
-	// Handle --today flag
-	if getToday {
-		getDate = time.Now().Format("2006-01-02")
+	startDate, endDate, err := getDates.resolve()
+	if err != nil {
+		return err
 	}
 
-	// Parse date filters
-	var startDate, endDate time.Time
-
-	// Handle week filter
-	if getWeek > 0 {
-		if getWeek < 1 || getWeek > 53 {
-			return fmt.Errorf("%s", i18n.T(i18n.KeyErrWeekRange))
-		}
-
-		year := getYear
-		if year == 0 {
-			year = time.Now().Year()
-		}
-
-		// Find the first day of the week
-		// Start from January 1st of the year and find the first Monday
-		jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
-
-		// Calculate the Monday of week 1 (ISO 8601: week 1 is the first week with Thursday)
-		// Find the first Thursday
-		daysUntilThursday := (11 - int(jan1.Weekday())) % 7
-		firstThursday := jan1.AddDate(0, 0, daysUntilThursday)
-
-		// The Monday of week 1 is 3 days before the first Thursday
-		firstMonday := firstThursday.AddDate(0, 0, -3)
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
 
-		// Calculate the start date of the requested week
-		startDate = firstMonday.AddDate(0, 0, 7*(getWeek-1))
-		endDate = startDate.AddDate(0, 0, 7)
-	} else if getDate != "" {
-		parsedDate, err := time.Parse("2006-01-02", getDate)
+	// --group-by selects the subtotals, --summary alone shows the default ones
+	opts := output.Options{ShowIDs: getShowIDs, DefaultVATRate: &cfg.Invoice.VATRate, Rounding: roundingRule(cfg)}
+	opts.Converter, err = loadConverter(cfg, getCurrency)
+	if err != nil {
+		return err
+	}
+	if getGroupBy != "" {
+		opts.GroupBy, err = output.ParseGroupBy(getGroupBy)
 		if err != nil {
-			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrInvalidDateFormat), err)
-		}
-		startDate = parsedDate
-		endDate = parsedDate.AddDate(0, 0, 1)
-	} else if getMonth > 0 {
-		// Handle month filter
-		if getMonth < 1 || getMonth > 12 {
-			return fmt.Errorf("%s", i18n.T(i18n.KeyErrMonthRange))
-		}
-
-		year := getYear
-		if year == 0 {
-			year = time.Now().Year()
-		}
-
-		startDate = time.Date(year, time.Month(getMonth), 1, 0, 0, 0, 0, time.UTC)
-		endDate = startDate.AddDate(0, 1, 0)
-	} else if getYear > 0 {
-		// Handle year filter (when specified without month/week)
-		startDate = time.Date(getYear, time.January, 1, 0, 0, 0, 0, time.UTC)
-		endDate = startDate.AddDate(1, 0, 0) // Next year
-	} else {
-		// Handle from/to date range
-		if getFromDate != "" {
-			parsedDate, err := time.Parse("2006-01-02", getFromDate)
-			if err != nil {
-				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrInvalidDateFormat), err)
-			}
-			startDate = parsedDate
-		}
-		if getToDate != "" {
-			parsedDate, err := time.Parse("2006-01-02", getToDate)
-			if err != nil {
-				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrInvalidDateFormat), err)
-			}
-			endDate = parsedDate.AddDate(0, 0, 1) // Include the entire day
-		}
-
-		// If no date filters specified at all, default to current year and month
-		if startDate.IsZero() && endDate.IsZero() {
-			now := time.Now()
-			startDate = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
-			endDate = startDate.AddDate(0, 1, 0)
+			return err
 		}
+	} else if getSummary {
+		opts.GroupBy = output.DefaultGroupBy
+	}
+	archived, err := parseArchivedFilter(getArchived)
+	if err != nil {
+		return err
 	}
 
 	// Fetch entries
@@ -140,6 +86,7 @@ func runGet(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
 	}
+	entries = archived.filter(entries)
 
 	if len(entries) == 0 {
 		fmt.Println(i18n.T(i18n.KeyGetNoResults))
@@ -159,7 +106,7 @@ func runGet(cmd *cobra.Command, args []string) error {
 
 	// Get appropriate formatter
 	format := output.Format(getOutput)
-	formatter := output.GetFormatter(format)
+	formatter := output.GetFormatter(format, opts)
 
 	// Format and output results
 	if err := formatter.Format(entries, writer); err != nil {
@@ -181,13 +128,47 @@ func localizeGetCommand() {
 	getCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyGetFlagConsultant)
 	getCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyGetFlagProject)
 	getCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyGetFlagCustomer)
-	getCmd.Flags().Lookup("month").Usage = i18n.T(i18n.KeyGetFlagMonth)
-	getCmd.Flags().Lookup("from").Usage = i18n.T(i18n.KeyGetFlagFromDate)
-	getCmd.Flags().Lookup("to").Usage = i18n.T(i18n.KeyGetFlagToDate)
-	getCmd.Flags().Lookup("date").Usage = i18n.T(i18n.KeyGetFlagDate)
-	getCmd.Flags().Lookup("today").Usage = i18n.T(i18n.KeyGetFlagToday)
-	getCmd.Flags().Lookup("week").Usage = i18n.T(i18n.KeyGetFlagWeek)
-	getCmd.Flags().Lookup("year").Usage = i18n.T(i18n.KeyGetFlagYear)
+	localizeDateRangeFlags(getCmd)
 	getCmd.Flags().Lookup("output").Usage = i18n.T(i18n.KeyGetFlagOutput)
 	getCmd.Flags().Lookup("output-file").Usage = i18n.T(i18n.KeyGetFlagOutputFile)
+	getCmd.Flags().Lookup("ids").Usage = i18n.T(i18n.KeyGetFlagShowIDs)
+	getCmd.Flags().Lookup("summary").Usage = i18n.T(i18n.KeyGetFlagSummary)
+	getCmd.Flags().Lookup("group-by").Usage = i18n.T(i18n.KeyGetFlagGroupBy)
+	getCmd.Flags().Lookup("currency").Usage = i18n.T(i18n.KeyGetFlagCurrency)
+	getCmd.Flags().Lookup("archived").Usage = i18n.T(i18n.KeyGetFlagArchived)
+}
+
+// archivedFilter selects work logs by whether their consultant, project or customer is archived
+type archivedFilter string
+
+const (
+	archivedInclude archivedFilter = "include" // all work logs
+	archivedExclude archivedFilter = "exclude" // only those of active consultants, projects and customers
+	archivedOnly    archivedFilter = "only"    // only those with an archived consultant, project or customer
+)
+
+func parseArchivedFilter(value string) (archivedFilter, error) {
+	filter := archivedFilter(strings.ToLower(strings.TrimSpace(value)))
+	switch filter {
+	case archivedInclude, archivedExclude, archivedOnly:
+		return filter, nil
+	}
+	return "", fmt.Errorf(i18n.T(i18n.KeyErrInvalidArchived), value)
+}
+
+// filter returns the entries the filter selects. The project, customer and consultant of
+// each entry must be loaded.
+func (f archivedFilter) filter(entries []models.TimeEntry) []models.TimeEntry {
+	if f == archivedInclude {
+		return entries
+	}
+
+	var selected []models.TimeEntry
+	for _, entry := range entries {
+		archived := !entry.Consultant.Active || !entry.Project.Active || !entry.Project.Customer.Active
+		if archived == (f == archivedOnly) {
+			selected = append(selected, entry)
+		}
+	}
+	return selected
 }
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/importer"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importDryRun        bool
+	importAllowInactive bool
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	importCmd.PersistentFlags().BoolVar(&importDryRun, "dry-run", false, "")
+	importCmd.PersistentFlags().BoolVar(&importAllowInactive, "allow-inactive", false, "")
+}
+
+func localizeImportCommand() {
+	importCmd.Short = i18n.T(i18n.KeyImportShort)
+	importCmd.Long = i18n.T(i18n.KeyImportLong)
+
+	importCmd.PersistentFlags().Lookup("dry-run").Usage = i18n.T(i18n.KeyImportFlagDryRun)
+	importCmd.PersistentFlags().Lookup("allow-inactive").Usage = i18n.T(i18n.KeyImportFlagAllowInactive)
+
+	localizeImportCSVCommand()
+	localizeImportJSONCommand()
+	localizeImportToolCommands()
+}
+
+// importResult counts what happened to the rows of an import
+type importResult struct {
+	created int
+	merged  int
+	skipped int
+	// reusedIDs are IDs of rows imported as new entries because the entry with that ID in
+	// this database holds something else, as when an export of another database is imported
+	reusedIDs []uint
+}
+
+// errDryRun rolls back the import transaction of a dry run
+var errDryRun = errors.New("dry run")
+
+// importRows saves records in one transaction. Rows that have been imported before, and rows
+// exported from this database, are skipped, so importing the same file twice or importing an
+// export back has no effect. Rows whose ID belongs to an entry with other content are imported
+// and their IDs reported, since IDs of different databases overlap. Other rows are created or
+// merged into a matching entry exactly like `worklog add` does, also refusing archived
+// consultants, customers and projects unless allowInactive is set. A dry run performs the
+// import and rolls it back.
+func importRows(repo *database.Repository, records []importer.Record, source string, dryRun, allowInactive bool) (importResult, error) {
+	var result importResult
+	occurrences := make(map[string]int)
+
+	err := repo.Transaction(func(tx *database.Repository) error {
+		for _, record := range records {
+			// Identical rows within one file are distinguished by their occurrence
+			fingerprint := record.Fingerprint()
+			hash := importHash(fingerprint, occurrences[fingerprint])
+			occurrences[fingerprint]++
+
+			exists, err := tx.ImportRecordExists(hash)
+			if err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCheckExistingEntry), err)
+			}
+			if !exists && record.ID != 0 {
+				entry, err := tx.GetTimeEntryByID(record.ID)
+				if err != nil {
+					return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCheckExistingEntry), err)
+				}
+				if entry != nil {
+					exists = exportedRecord(entry).Fingerprint() == fingerprint
+					if !exists {
+						result.reusedIDs = append(result.reusedIDs, record.ID)
+					}
+				}
+			}
+			if exists {
+				result.skipped++
+				continue
+			}
+
+			target := entryTarget{consultant: record.Consultant, client: record.Customer, project: record.Project, rate: record.HourlyRate, allowInactive: allowInactive}
+			consultantObj, projectObj, err := target.resolve(tx)
+			if err != nil {
+				return err
+			}
+
+			entry := &models.TimeEntry{
+				Date:         record.Date,
+				StartTime:    record.StartTime,
+				EndTime:      record.EndTime,
+				Hours:        record.Hours,
+				Description:  record.Description,
+				HourlyRate:   record.HourlyRate,
+				ProjectID:    projectObj.ID,
+				ConsultantID: consultantObj.ID,
+			}
+			merged, err := saveTimeEntry(tx, entry)
+			if err != nil {
+				return err
+			}
+			if merged {
+				result.merged++
+			} else {
+				result.created++
+			}
+
+			importRecord := &models.ImportRecord{Hash: hash, Source: source, TimeEntryID: entry.ID}
+			if err := tx.CreateImportRecord(importRecord); err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrSaveWorkLog), err)
+			}
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && err != errDryRun {
+		return importResult{}, err
+	}
+
+	return result, nil
+}
+
+// exportedRecord returns the record an export of the entry reads back as. A row whose
+// fingerprint equals it was exported from this database, and saving it would add its hours
+// onto the entry a second time. Exports write times as clock times, which are read back on
+// the date of the entry.
+func exportedRecord(entry *models.TimeEntry) importer.Record {
+	record := importer.Record{
+		ID:          entry.ID,
+		Date:        entry.Date,
+		Consultant:  entry.Consultant.Name,
+		Customer:    entry.Project.Customer.Name,
+		Project:     entry.Project.Name,
+		Description: entry.Description,
+		Hours:       entry.Hours,
+		HourlyRate:  entry.HourlyRate,
+	}
+	if entry.HasTimes() {
+		start, end := clockOn(entry.Date, *entry.StartTime), clockOn(entry.Date, *entry.EndTime)
+		record.StartTime, record.EndTime = &start, &end
+	}
+	return record
+}
+
+func importHash(fingerprint string, occurrence int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x1f%d", fingerprint, occurrence)))
+	return hex.EncodeToString(sum[:])
+}
+
+// printImportErrors lists rejected rows using the message that describes their position,
+// and returns the error that aborts the import
+func printImportErrors(rowErrors []importer.RowError, positionKey string) error {
+	for _, rowErr := range rowErrors {
+		fmt.Printf(i18n.T(positionKey)+"\n", rowErr.Position, rowErr.Err)
+	}
+	return fmt.Errorf(i18n.T(i18n.KeyErrImportInvalidRows), len(rowErrors))
+}
+
+func printImportResult(result importResult, source string, dryRun bool) {
+	if dryRun {
+		fmt.Println(i18n.T(i18n.KeyImportDryRun))
+	}
+	fmt.Printf(i18n.T(i18n.KeyImportSummary)+"\n", source)
+	fmt.Printf(i18n.T(i18n.KeyImportCreated)+"\n", result.created)
+	fmt.Printf(i18n.T(i18n.KeyImportMerged)+"\n", result.merged)
+	fmt.Printf(i18n.T(i18n.KeyImportSkipped)+"\n", result.skipped)
+	if len(result.reusedIDs) > 0 {
+		ids := make([]string, len(result.reusedIDs))
+		for i, id := range result.reusedIDs {
+			ids[i] = strconv.FormatUint(uint64(id), 10)
+		}
+		fmt.Printf(i18n.T(i18n.KeyImportReusedIDs)+"\n", len(ids), strings.Join(ids, ", "))
+	}
+}
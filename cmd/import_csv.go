@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+var importCSVCmd = &cobra.Command{
+	Use:   "csv <file>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runImportCSV,
+}
+
+func init() {
+	importCmd.AddCommand(importCSVCmd)
+}
+
+func localizeImportCSVCommand() {
+	importCSVCmd.Short = i18n.T(i18n.KeyImportCSVShort)
+	importCSVCmd.Long = i18n.T(i18n.KeyImportCSVLong)
+}
+
+func runImportCSV(cmd *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportOpenFile), err)
+	}
+	defer f.Close()
+
+	records, rowErrors, err := importer.ParseWorklogCSV(f)
+	if err != nil {
+		return err
+	}
+	if len(rowErrors) > 0 {
+		return printImportErrors(rowErrors, i18n.KeyImportRowError)
+	}
+
+	result, err := importRows(database.NewRepository(), records, args[0], importDryRun, importAllowInactive)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportFailed), err)
+	}
+
+	printImportResult(result, args[0], importDryRun)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+var importJSONCmd = &cobra.Command{
+	Use:   "json [file]",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runImportJSON,
+}
+
+func init() {
+	importCmd.AddCommand(importJSONCmd)
+}
+
+func localizeImportJSONCommand() {
+	importJSONCmd.Short = i18n.T(i18n.KeyImportJSONShort)
+	importJSONCmd.Long = i18n.T(i18n.KeyImportJSONLong)
+}
+
+func runImportJSON(cmd *cobra.Command, args []string) error {
+	// Read from stdin unless a file is given
+	var input io.Reader = os.Stdin
+	source := "stdin"
+	if len(args) == 1 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportOpenFile), err)
+		}
+		defer f.Close()
+		input = f
+		source = args[0]
+	}
+
+	records, rowErrors, err := importer.ParseWorklogJSON(input)
+	if err != nil {
+		return err
+	}
+	if len(rowErrors) > 0 {
+		return printImportErrors(rowErrors, i18n.KeyImportEntryError)
+	}
+
+	result, err := importRows(database.NewRepository(), records, source, importDryRun, importAllowInactive)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportFailed), err)
+	}
+
+	printImportResult(result, source, importDryRun)
+
+	return nil
+}
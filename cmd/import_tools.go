@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importToolConsultant string
+	importToolMapping    string
+	importToolRate       string
+)
+
+// importTools lists the time tracking tools whose CSV exports can be imported
+var importTools = []struct {
+	name     string
+	mapping  importer.Mapping
+	shortKey string
+	longKey  string
+}{
+	{"toggl", importer.Toggl, i18n.KeyImportTogglShort, i18n.KeyImportTogglLong},
+	{"clockify", importer.Clockify, i18n.KeyImportClockifyShort, i18n.KeyImportClockifyLong},
+	{"harvest", importer.Harvest, i18n.KeyImportHarvestShort, i18n.KeyImportHarvestLong},
+}
+
+var importToolCmds = make(map[string]*cobra.Command)
+
+func init() {
+	for _, tool := range importTools {
+		mapping := tool.mapping
+		cmd := &cobra.Command{
+			Use:   tool.name + " <file>",
+			Short: "", // Set after i18n initialization
+			Long:  "", // Set after i18n initialization
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runImportTool(mapping, args[0])
+			},
+		}
+
+		cmd.Flags().StringVarP(&importToolConsultant, "consultant", "n", "", "")
+		cmd.Flags().StringVarP(&importToolMapping, "mapping", "m", "", "")
+		cmd.Flags().StringVarP(&importToolRate, "rate", "r", "", "")
+
+		importCmd.AddCommand(cmd)
+		importToolCmds[tool.name] = cmd
+	}
+}
+
+func localizeImportToolCommands() {
+	for _, tool := range importTools {
+		cmd := importToolCmds[tool.name]
+		cmd.Short = i18n.T(tool.shortKey)
+		cmd.Long = i18n.T(tool.longKey)
+
+		cmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyImportToolFlagConsultant)
+		cmd.Flags().Lookup("mapping").Usage = i18n.T(i18n.KeyImportToolFlagMapping)
+		cmd.Flags().Lookup("rate").Usage = i18n.T(i18n.KeyImportToolFlagRate)
+	}
+}
+
+func runImportTool(mapping importer.Mapping, path string) error {
+	// Columns named in a mapping file replace those of the built-in mapping
+	if importToolMapping != "" {
+		override, err := importer.LoadMapping(importToolMapping)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportMapping), err)
+		}
+		mapping = mapping.Override(override)
+	}
+
+	// Rows without a billable rate fall back to --rate, then to the configured default
+	rate, err := parseOptionalRate(importToolRate)
+	if err != nil {
+		return err
+	}
+	if rate == 0 {
+		cfg, err := config.Get()
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+		}
+		if rate, err = configuredRate(cfg); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportOpenFile), err)
+	}
+	defer f.Close()
+
+	records, rowErrors, err := importer.ParseCSV(f, mapping, importer.Options{
+		Consultant: importToolConsultant,
+		Rate:       rate,
+	})
+	if err != nil {
+		return err
+	}
+	if len(rowErrors) > 0 {
+		return printImportErrors(rowErrors, i18n.KeyImportRowError)
+	}
+
+	result, err := importRows(database.NewRepository(), records, path, importDryRun, importAllowInactive)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportFailed), err)
+	}
+
+	printImportResult(result, path, importDryRun)
+
+	return nil
+}
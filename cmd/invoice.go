@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var invoiceCmd = &cobra.Command{
+	Use:   "invoice",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+}
+
+func init() {
+	rootCmd.AddCommand(invoiceCmd)
+}
+
+func localizeInvoiceCommand() {
+	invoiceCmd.Short = i18n.T(i18n.KeyInvoiceShort)
+	invoiceCmd.Long = i18n.T(i18n.KeyInvoiceLong)
+
+	localizeInvoiceCreateCommand()
+	localizeInvoiceListCommand()
+	localizeInvoiceShowCommand()
+	localizeInvoicePDFCommand()
+	localizeInvoiceMarkCommand()
+	localizeInvoiceVoidCommand()
+}
+
+// loadInvoice fetches the invoice with the given number and its entries, failing if it does not exist
+func loadInvoice(repo *database.Repository, number string) (*models.Invoice, error) {
+	invoice, err := repo.GetInvoiceByNumber(number)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchInvoice), err)
+	}
+	if invoice == nil {
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrInvoiceNotFound), number)
+	}
+	return invoice, nil
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/dateparse"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// defaultInvoiceDueDays is used when no payment terms are configured
+const defaultInvoiceDueDays = 30
+
+var (
+	invoiceCustomer  string
+	invoiceDates     dateRangeFlags
+	invoiceIssueDate string
+	invoiceDueDays   int
+	invoiceDryRun    bool
+)
+
+var invoiceCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runInvoiceCreate,
+}
+
+func init() {
+	invoiceCmd.AddCommand(invoiceCreateCmd)
+
+	invoiceCreateCmd.Flags().StringVarP(&invoiceCustomer, "customer", "c", "", "")
+	addDateRangeFlags(invoiceCreateCmd, &invoiceDates)
+	invoiceCreateCmd.Flags().StringVar(&invoiceIssueDate, "issue-date", "", "")
+	invoiceCreateCmd.Flags().IntVar(&invoiceDueDays, "due-days", 0, "")
+	invoiceCreateCmd.Flags().BoolVar(&invoiceDryRun, "dry-run", false, "")
+}
+
+func localizeInvoiceCreateCommand() {
+	invoiceCreateCmd.Short = i18n.T(i18n.KeyInvoiceCreateShort)
+	invoiceCreateCmd.Long = i18n.T(i18n.KeyInvoiceCreateLong)
+
+	invoiceCreateCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyInvoiceCreateFlagCustomer)
+	localizeDateRangeFlags(invoiceCreateCmd)
+	invoiceCreateCmd.Flags().Lookup("issue-date").Usage = i18n.T(i18n.KeyInvoiceCreateFlagIssueDate)
+	invoiceCreateCmd.Flags().Lookup("due-days").Usage = i18n.T(i18n.KeyInvoiceCreateFlagDueDays)
+	invoiceCreateCmd.Flags().Lookup("dry-run").Usage = i18n.T(i18n.KeyInvoiceCreateFlagDryRun)
+}
+
+func runInvoiceCreate(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+
+	if invoiceCustomer == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvoiceCustomerMissing))
+	}
+
+	startDate, endDate, err := invoiceDates.resolve()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	issueDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if invoiceIssueDate != "" {
+		issueDate, err = dateparse.Parse(invoiceIssueDate, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	dueDays := cfg.Invoice.DueDays
+	if dueDays == 0 {
+		dueDays = defaultInvoiceDueDays
+	}
+	if cmd.Flags().Changed("due-days") {
+		dueDays = invoiceDueDays
+	}
+	if dueDays < 0 {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvalidInvoiceSettings))
+	}
+
+	repo := database.NewRepository()
+
+	customer, err := repo.GetCustomerByName(invoiceCustomer)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrGetCreateCustomer), err)
+	}
+	if customer == nil {
+		return fmt.Errorf(i18n.T(i18n.KeyErrCustomerNotFound), invoiceCustomer)
+	}
+
+	entries, err := repo.GetUninvoicedTimeEntries(customer.Name, startDate, endDate)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf(i18n.T(i18n.KeyErrInvoiceNothingToBill), customer.Name)
+	}
+
+	// Open ends of the period are closed by the entries themselves
+	periodStart, periodEnd := startDate, endDate.AddDate(0, 0, -1)
+	if startDate.IsZero() {
+		periodStart = entries[0].Date
+	}
+	if endDate.IsZero() {
+		periodEnd = entries[len(entries)-1].Date
+	}
+
+	invoice := models.Invoice{
+		Series:        strings.ReplaceAll(cfg.Invoice.Prefix, "{year}", strconv.Itoa(issueDate.Year())),
+		CustomerID:    customer.ID,
+		Customer:      *customer,
+		PeriodStart:   periodStart,
+		PeriodEnd:     periodEnd,
+		IssueDate:     issueDate,
+		DueDate:       issueDate.AddDate(0, 0, dueDays),
+		Status:        models.InvoiceDraft,
+		VATRate:       customer.EffectiveVATRate(cfg.Invoice.VATRate),
+		ReverseCharge: customer.ReverseCharge,
+		Currency:      customer.CurrencyCode(),
+		Rounding:      roundingRule(cfg),
+		TimeEntries:   entries,
+	}
+	hours, amount := invoice.Totals()
+
+	ids := make([]uint, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+
+	if err := output.GetFormatter(output.FormatTable, output.Options{ShowIDs: true, Rounding: invoice.Rounding}).Format(entries, os.Stdout); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	// The number is taken inside the transaction, so concurrent invoices cannot get the same one
+	err = repo.Transaction(func(tx *database.Repository) error {
+		last, err := tx.LastInvoiceSequence(invoice.Series)
+		if err != nil {
+			return err
+		}
+		invoice.Sequence = max(last+1, cfg.Invoice.FirstNumber)
+		invoice.Number = invoice.Series + fmt.Sprintf("%0*d", cfg.Invoice.Digits, invoice.Sequence)
+
+		if invoiceDryRun {
+			return errDryRun
+		}
+
+		if err := tx.CreateInvoice(&invoice); err != nil {
+			return err
+		}
+		return tx.AttachTimeEntries(invoice.ID, ids)
+	})
+	if invoiceDryRun && err == errDryRun {
+		fmt.Printf(i18n.T(i18n.KeyInvoiceCreateDryRun)+"\n", invoice.Number, len(entries), hours, currency.Format(amount, invoice.Currency))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCreateInvoice), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyInvoiceCreateSuccess)+"\n", invoice.Number, customer.Name, len(entries), hours, currency.Format(amount, invoice.Currency))
+	fmt.Printf(i18n.T(i18n.KeyInvoiceCreateDue)+"\n", invoice.DueDate.Format("2006-01-02"))
+
+	return nil
+}
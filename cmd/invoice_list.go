@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	invoiceListCustomer string
+	invoiceListStatus   string
+)
+
+var invoiceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runInvoiceList,
+}
+
+func init() {
+	invoiceCmd.AddCommand(invoiceListCmd)
+
+	invoiceListCmd.Flags().StringVarP(&invoiceListCustomer, "customer", "c", "", "")
+	invoiceListCmd.Flags().StringVar(&invoiceListStatus, "status", "", "")
+}
+
+func localizeInvoiceListCommand() {
+	invoiceListCmd.Short = i18n.T(i18n.KeyInvoiceListShort)
+	invoiceListCmd.Long = i18n.T(i18n.KeyInvoiceListLong)
+
+	invoiceListCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyGetFlagCustomer)
+	invoiceListCmd.Flags().Lookup("status").Usage = i18n.T(i18n.KeyInvoiceListFlagStatus)
+}
+
+func runInvoiceList(cmd *cobra.Command, args []string) error {
+	status := models.InvoiceStatus(invoiceListStatus)
+	switch status {
+	case "", models.InvoiceDraft, models.InvoiceSent, models.InvoicePaid, models.InvoiceVoid:
+	default:
+		return fmt.Errorf(i18n.T(i18n.KeyErrInvalidInvoiceStatus), invoiceListStatus)
+	}
+
+	repo := database.NewRepository()
+	invoices, err := repo.GetInvoices(invoiceListCustomer, status)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchInvoice), err)
+	}
+
+	if len(invoices) == 0 {
+		fmt.Println(i18n.T(i18n.KeyInvoiceListNoResults))
+		return nil
+	}
+
+	return output.FormatInvoices(invoices, os.Stdout)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var invoiceMarkCmd = &cobra.Command{
+	Use:       "mark <number> <draft|sent|paid>",
+	Short:     "", // Set after i18n initialization
+	Long:      "", // Set after i18n initialization
+	Args:      cobra.ExactArgs(2),
+	ValidArgs: []string{string(models.InvoiceDraft), string(models.InvoiceSent), string(models.InvoicePaid)},
+	RunE:      runInvoiceMark,
+}
+
+func init() {
+	invoiceCmd.AddCommand(invoiceMarkCmd)
+}
+
+func localizeInvoiceMarkCommand() {
+	invoiceMarkCmd.Short = i18n.T(i18n.KeyInvoiceMarkShort)
+	invoiceMarkCmd.Long = i18n.T(i18n.KeyInvoiceMarkLong)
+}
+
+func runInvoiceMark(cmd *cobra.Command, args []string) error {
+	status := models.InvoiceStatus(args[1])
+	switch status {
+	case models.InvoiceDraft, models.InvoiceSent, models.InvoicePaid:
+	default:
+		return fmt.Errorf(i18n.T(i18n.KeyErrInvalidInvoiceStatus), args[1])
+	}
+
+	repo := database.NewRepository()
+
+	invoice, err := loadInvoice(repo, args[0])
+	if err != nil {
+		return err
+	}
+	if invoice.Status == models.InvoiceVoid {
+		return fmt.Errorf(i18n.T(i18n.KeyErrInvoiceIsVoid), invoice.Number)
+	}
+
+	if err := repo.UpdateInvoiceStatus(invoice.ID, status); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateInvoice), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyInvoiceMarkSuccess)+"\n", invoice.Number, output.InvoiceStatusLabel(status))
+
+	return nil
+}
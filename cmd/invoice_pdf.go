@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	invoicePDFOutputFile string
+	invoicePDFLanguage   string
+)
+
+var invoicePDFCmd = &cobra.Command{
+	Use:   "pdf <number>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runInvoicePDF,
+}
+
+func init() {
+	invoiceCmd.AddCommand(invoicePDFCmd)
+
+	invoicePDFCmd.Flags().StringVar(&invoicePDFOutputFile, "output-file", "", "")
+	invoicePDFCmd.Flags().StringVarP(&invoicePDFLanguage, "language", "l", "", "")
+}
+
+func localizeInvoicePDFCommand() {
+	invoicePDFCmd.Short = i18n.T(i18n.KeyInvoicePDFShort)
+	invoicePDFCmd.Long = i18n.T(i18n.KeyInvoicePDFLong)
+
+	invoicePDFCmd.Flags().Lookup("output-file").Usage = i18n.T(i18n.KeyInvoicePDFFlagOutputFile)
+	invoicePDFCmd.Flags().Lookup("language").Usage = i18n.T(i18n.KeyInvoicePDFFlagLanguage)
+}
+
+func runInvoicePDF(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+
+	repo := database.NewRepository()
+
+	invoice, err := loadInvoice(repo, args[0])
+	if err != nil {
+		return err
+	}
+	// A voided invoice has released its entries, so there is nothing left to print
+	if invoice.Status == models.InvoiceVoid {
+		return fmt.Errorf(i18n.T(i18n.KeyErrInvoicePDFVoid), invoice.Number)
+	}
+
+	lang := i18n.GetCurrentLanguage()
+	if invoice.Customer.Language != "" {
+		lang = i18n.Normalize(invoice.Customer.Language)
+	}
+	if invoicePDFLanguage != "" {
+		lang = i18n.Normalize(invoicePDFLanguage)
+	}
+
+	path := invoicePDFOutputFile
+	if path == "" {
+		path = invoice.Number + ".pdf"
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCreateOutputFile), err)
+	}
+	defer f.Close()
+
+	if err := output.WriteInvoicePDF(*invoice, cfg.Seller, lang, f); err != nil {
+		return err
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyInvoicePDFSuccess)+"\n", invoice.Number, path)
+	return nil
+}
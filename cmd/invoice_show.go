@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var invoiceShowCmd = &cobra.Command{
+	Use:   "show <number>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runInvoiceShow,
+}
+
+func init() {
+	invoiceCmd.AddCommand(invoiceShowCmd)
+}
+
+func localizeInvoiceShowCommand() {
+	invoiceShowCmd.Short = i18n.T(i18n.KeyInvoiceShowShort)
+	invoiceShowCmd.Long = i18n.T(i18n.KeyInvoiceShowLong)
+}
+
+func runInvoiceShow(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+
+	invoice, err := loadInvoice(repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := output.FormatInvoice(*invoice, os.Stdout); err != nil {
+		return err
+	}
+
+	// A voided invoice has released its entries
+	if len(invoice.TimeEntries) == 0 {
+		return nil
+	}
+	fmt.Println()
+	return output.GetFormatter(output.FormatTable, output.Options{ShowIDs: true, Rounding: invoice.Rounding}).Format(invoice.TimeEntries, os.Stdout)
+}
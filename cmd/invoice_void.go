@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var invoiceVoidYes bool
+
+var invoiceVoidCmd = &cobra.Command{
+	Use:   "void <number>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runInvoiceVoid,
+}
+
+func init() {
+	invoiceCmd.AddCommand(invoiceVoidCmd)
+
+	invoiceVoidCmd.Flags().BoolVar(&invoiceVoidYes, "yes", false, "")
+}
+
+func localizeInvoiceVoidCommand() {
+	invoiceVoidCmd.Short = i18n.T(i18n.KeyInvoiceVoidShort)
+	invoiceVoidCmd.Long = i18n.T(i18n.KeyInvoiceVoidLong)
+
+	invoiceVoidCmd.Flags().Lookup("yes").Usage = i18n.T(i18n.KeyInvoiceVoidFlagYes)
+}
+
+func runInvoiceVoid(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+
+	invoice, err := loadInvoice(repo, args[0])
+	if err != nil {
+		return err
+	}
+	switch invoice.Status {
+	case models.InvoiceVoid:
+		return fmt.Errorf(i18n.T(i18n.KeyErrInvoiceIsVoid), invoice.Number)
+	case models.InvoicePaid:
+		return fmt.Errorf(i18n.T(i18n.KeyErrInvoiceIsPaid), invoice.Number)
+	}
+
+	if !invoiceVoidYes {
+		ok, err := confirm(fmt.Sprintf(i18n.T(i18n.KeyInvoiceVoidConfirm), invoice.Number, len(invoice.TimeEntries)))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println(i18n.T(i18n.KeyInvoiceVoidAborted))
+			return nil
+		}
+	}
+
+	if err := repo.VoidInvoice(invoice.ID); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateInvoice), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyInvoiceVoidSuccess)+"\n", invoice.Number, len(invoice.TimeEntries))
+
+	return nil
+}
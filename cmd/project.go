@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var projectCmd = &cobra.Command{
+	Use:   "project",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+}
+
+func init() {
+	rootCmd.AddCommand(projectCmd)
+}
+
+func localizeProjectCommand() {
+	projectCmd.Short = i18n.T(i18n.KeyProjectShort)
+	projectCmd.Long = i18n.T(i18n.KeyProjectLong)
+
+	localizeProjectListCommand()
+	localizeProjectAddCommand()
+	localizeProjectRenameCommand()
+	localizeProjectArchiveCommand()
+	localizeProjectMoveCommand()
+	localizeProjectShowCommand()
+	localizeProjectSetCommand()
+}
+
+// findProject returns the project a name given on the command line refers to, with its
+// customer loaded, or nil if there is none. With a customer the name is looked up among
+// the projects of that customer first, then as a project code. Without one the code comes
+// first, then a name that only one customer has a project by.
+func findProject(repo *database.Repository, name, customerName string) (*models.Project, error) {
+	if customerName != "" {
+		customer, err := repo.GetCustomerByName(customerName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+		}
+		if customer != nil {
+			project, err := repo.GetProjectByName(name, customer.ID)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+			}
+			if project != nil {
+				project.Customer = *customer
+				return project, nil
+			}
+		}
+	}
+
+	project, err := repo.GetProjectByCode(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+	}
+	if project != nil || customerName != "" {
+		return project, nil
+	}
+
+	projects, err := repo.GetProjectsByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+	}
+	switch len(projects) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &projects[0], nil
+	default:
+		customers := make([]string, len(projects))
+		for i, project := range projects {
+			customers[i] = project.Customer.Name
+		}
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrProjectAmbiguous), name, strings.Join(customers, ", "))
+	}
+}
+
+// loadProject returns the project a name or code refers to, or an error if there is none
+func loadProject(repo *database.Repository, name, customerName string) (*models.Project, error) {
+	project, err := findProject(repo, name, customerName)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		if customerName != "" {
+			return nil, fmt.Errorf(i18n.T(i18n.KeyErrProjectNotFound), name, customerName)
+		}
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrProjectUnknown), name)
+	}
+	return project, nil
+}
+
+// checkProjectNameFree fails if the customer has a project other than the one with the
+// given ID by that name, also when the names differ only in case or surrounding spaces
+func checkProjectNameFree(repo *database.Repository, name string, customer *models.Customer, id uint) error {
+	existing, err := repo.GetProjectByName(name, customer.ID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+	}
+	if existing == nil {
+		existing, err = repo.FindSimilarProject(name, customer.ID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+		}
+	}
+	if existing != nil && existing.ID != id {
+		return fmt.Errorf(i18n.T(i18n.KeyErrProjectExists), existing.Name, customer.Name)
+	}
+	return nil
+}
+
+// projectCode validates a code given on the command line and checks that no project other
+// than the one with the given ID has it. An empty value removes the code and returns nil.
+func projectCode(repo *database.Repository, value string, id uint) (*string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	code, ok := models.ParseProjectCode(value)
+	if !ok {
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrProjectCodeInvalid), value)
+	}
+
+	existing, err := repo.GetProjectByCode(code)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+	}
+	if existing != nil && existing.ID != id {
+		return nil, fmt.Errorf(i18n.T(i18n.KeyErrProjectCodeTaken), code, existing.Name, existing.Customer.Name)
+	}
+	return &code, nil
+}
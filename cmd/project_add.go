@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var (
+	projectAddCustomer    string
+	projectAddCode        string
+	projectAddDescription string
+)
+
+var projectAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProjectAdd,
+}
+
+func init() {
+	projectCmd.AddCommand(projectAddCmd)
+
+	projectAddCmd.Flags().StringVarP(&projectAddCustomer, "customer", "c", "", "")
+	projectAddCmd.Flags().StringVar(&projectAddCode, "code", "", "")
+	projectAddCmd.Flags().StringVarP(&projectAddDescription, "description", "d", "", "")
+}
+
+func localizeProjectAddCommand() {
+	projectAddCmd.Short = i18n.T(i18n.KeyProjectAddShort)
+	projectAddCmd.Long = i18n.T(i18n.KeyProjectAddLong)
+
+	projectAddCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyProjectAddFlagCustomer)
+	projectAddCmd.Flags().Lookup("code").Usage = i18n.T(i18n.KeyProjectFlagCode)
+	projectAddCmd.Flags().Lookup("description").Usage = i18n.T(i18n.KeyProjectFlagDescription)
+}
+
+func runProjectAdd(cmd *cobra.Command, args []string) error {
+	name := strings.TrimSpace(args[0])
+	if name == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrProjectNameRequired))
+	}
+
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+	customerName := strings.TrimSpace(projectAddCustomer)
+	if customerName == "" {
+		customerName = cfg.DefaultClient
+	}
+	if customerName == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrCustomerRequired))
+	}
+
+	repo := database.NewRepository()
+	// The customer is created as `worklog add` would, unless strict mode is on
+	customer, err := customerFor(repo, customerName, cfg.Strict)
+	if err != nil {
+		return err
+	}
+	if err := checkProjectNameFree(repo, name, customer, 0); err != nil {
+		return err
+	}
+	code, err := projectCode(repo, projectAddCode, 0)
+	if err != nil {
+		return err
+	}
+
+	project := &models.Project{
+		Name:        name,
+		Code:        code,
+		CustomerID:  customer.ID,
+		Active:      true,
+		Description: strings.TrimSpace(projectAddDescription),
+	}
+	if err := repo.CreateProject(project); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCreateProject), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyProjectAddSuccess)+"\n", project.Name, customer.Name)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var (
+	projectArchiveCustomer string
+	projectArchiveUndo     bool
+)
+
+var projectArchiveCmd = &cobra.Command{
+	Use:   "archive <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProjectArchive,
+}
+
+func init() {
+	projectCmd.AddCommand(projectArchiveCmd)
+
+	projectArchiveCmd.Flags().StringVarP(&projectArchiveCustomer, "customer", "c", "", "")
+	projectArchiveCmd.Flags().BoolVar(&projectArchiveUndo, "undo", false, "")
+}
+
+func localizeProjectArchiveCommand() {
+	projectArchiveCmd.Short = i18n.T(i18n.KeyProjectArchiveShort)
+	projectArchiveCmd.Long = i18n.T(i18n.KeyProjectArchiveLong)
+
+	projectArchiveCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyProjectFlagCustomer)
+	projectArchiveCmd.Flags().Lookup("undo").Usage = i18n.T(i18n.KeyProjectArchiveFlagUndo)
+}
+
+func runProjectArchive(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	project, err := loadProject(repo, args[0], projectArchiveCustomer)
+	if err != nil {
+		return err
+	}
+
+	project.Active = projectArchiveUndo
+	if err := repo.UpdateProject(project); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateProject), err)
+	}
+
+	if projectArchiveUndo {
+		fmt.Printf(i18n.T(i18n.KeyProjectArchiveRestored)+"\n", project.Name, project.Customer.Name)
+	} else {
+		fmt.Printf(i18n.T(i18n.KeyProjectArchiveSuccess)+"\n", project.Name, project.Customer.Name)
+	}
+
+	return nil
+}
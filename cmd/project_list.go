@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	projectListCustomer string
+	projectListAll      bool
+)
+
+var projectListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runProjectList,
+}
+
+func init() {
+	projectCmd.AddCommand(projectListCmd)
+
+	projectListCmd.Flags().StringVarP(&projectListCustomer, "customer", "c", "", "")
+	projectListCmd.Flags().BoolVarP(&projectListAll, "all", "a", false, "")
+}
+
+func localizeProjectListCommand() {
+	projectListCmd.Short = i18n.T(i18n.KeyProjectListShort)
+	projectListCmd.Long = i18n.T(i18n.KeyProjectListLong)
+
+	projectListCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyProjectListFlagCustomer)
+	projectListCmd.Flags().Lookup("all").Usage = i18n.T(i18n.KeyProjectListFlagAll)
+}
+
+func runProjectList(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+
+	var all []models.Project
+	if projectListCustomer != "" {
+		customer, err := loadCustomer(repo, projectListCustomer)
+		if err != nil {
+			return err
+		}
+		all, err = repo.GetProjectsByCustomer(customer.ID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+		}
+		for i := range all {
+			all[i].Customer = *customer
+		}
+	} else {
+		var err error
+		all, err = repo.GetAllProjects()
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchProjects), err)
+		}
+	}
+
+	// The projects of an archived customer count as archived too
+	var projects []models.Project
+	for _, project := range all {
+		if (project.Active && project.Customer.Active) || projectListAll {
+			projects = append(projects, project)
+		}
+	}
+	if len(projects) == 0 {
+		fmt.Println(i18n.T(i18n.KeyProjectListNoResults))
+		return nil
+	}
+
+	activity, err := repo.ProjectActivity()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	return output.FormatProjects(projects, activity, os.Stdout)
+}
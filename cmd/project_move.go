@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var projectMoveCustomer string
+
+var projectMoveCmd = &cobra.Command{
+	Use:   "move <name> <customer>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(2),
+	RunE:  runProjectMove,
+}
+
+func init() {
+	projectCmd.AddCommand(projectMoveCmd)
+
+	projectMoveCmd.Flags().StringVarP(&projectMoveCustomer, "customer", "c", "", "")
+}
+
+func localizeProjectMoveCommand() {
+	projectMoveCmd.Short = i18n.T(i18n.KeyProjectMoveShort)
+	projectMoveCmd.Long = i18n.T(i18n.KeyProjectMoveLong)
+
+	projectMoveCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyProjectFlagCustomer)
+}
+
+func runProjectMove(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	project, err := loadProject(repo, args[0], projectMoveCustomer)
+	if err != nil {
+		return err
+	}
+	target, err := loadCustomer(repo, args[1])
+	if err != nil {
+		return err
+	}
+	if target.ID == project.CustomerID {
+		return fmt.Errorf(i18n.T(i18n.KeyErrProjectSameCustomer), project.Name, target.Name)
+	}
+	if err := checkProjectNameFree(repo, project.Name, target, project.ID); err != nil {
+		return err
+	}
+
+	// Invoiced work logs belong to an invoice of the current customer and must stay with it
+	invoiced, err := repo.CountInvoicedTimeEntries(project.ID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+	if invoiced > 0 {
+		return fmt.Errorf(i18n.T(i18n.KeyErrProjectInvoiced), project.Name, invoiced, project.Customer.Name)
+	}
+
+	source := project.Customer
+	project.CustomerID = target.ID
+	project.Customer = *target
+	if err := repo.UpdateProject(project); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateProject), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyProjectMoveSuccess)+"\n", project.Name, source.Name, target.Name)
+	// The rates of the work logs are kept as they are
+	if source.CurrencyCode() != target.CurrencyCode() {
+		fmt.Printf(i18n.T(i18n.KeyProjectMoveCurrency)+"\n", source.Name, source.CurrencyCode(), target.Name, target.CurrencyCode())
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var projectRenameCustomer string
+
+var projectRenameCmd = &cobra.Command{
+	Use:   "rename <name> <new name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(2),
+	RunE:  runProjectRename,
+}
+
+func init() {
+	projectCmd.AddCommand(projectRenameCmd)
+
+	projectRenameCmd.Flags().StringVarP(&projectRenameCustomer, "customer", "c", "", "")
+}
+
+func localizeProjectRenameCommand() {
+	projectRenameCmd.Short = i18n.T(i18n.KeyProjectRenameShort)
+	projectRenameCmd.Long = i18n.T(i18n.KeyProjectRenameLong)
+
+	projectRenameCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyProjectFlagCustomer)
+}
+
+func runProjectRename(cmd *cobra.Command, args []string) error {
+	newName := strings.TrimSpace(args[1])
+	if newName == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrProjectNameRequired))
+	}
+
+	repo := database.NewRepository()
+	project, err := loadProject(repo, args[0], projectRenameCustomer)
+	if err != nil {
+		return err
+	}
+	// Changing only the case of the name is allowed, but not taking the name of another project
+	if err := checkProjectNameFree(repo, newName, &project.Customer, project.ID); err != nil {
+		return err
+	}
+
+	oldName := project.Name
+	project.Name = newName
+	if err := repo.UpdateProject(project); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateProject), err)
+	}
+
+	// Keep the default project pointing at the renamed one
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+	if cfg.DefaultProject == oldName && cfg.DefaultClient == project.Customer.Name {
+		if err := config.SaveDefaults("", "", newName, 0, "", 0, 0); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyProjectRenameSuccess)+"\n", oldName, newName)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var (
+	projectSetCustomer    string
+	projectSetCode        string
+	projectSetDescription string
+)
+
+var projectSetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProjectSet,
+}
+
+func init() {
+	projectCmd.AddCommand(projectSetCmd)
+
+	projectSetCmd.Flags().StringVarP(&projectSetCustomer, "customer", "c", "", "")
+	projectSetCmd.Flags().StringVar(&projectSetCode, "code", "", "")
+	projectSetCmd.Flags().StringVarP(&projectSetDescription, "description", "d", "", "")
+}
+
+func localizeProjectSetCommand() {
+	projectSetCmd.Short = i18n.T(i18n.KeyProjectSetShort)
+	projectSetCmd.Long = i18n.T(i18n.KeyProjectSetLong)
+
+	projectSetCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyProjectFlagCustomer)
+	projectSetCmd.Flags().Lookup("code").Usage = i18n.T(i18n.KeyProjectFlagCode)
+	projectSetCmd.Flags().Lookup("description").Usage = i18n.T(i18n.KeyProjectFlagDescription)
+}
+
+func runProjectSet(cmd *cobra.Command, args []string) error {
+	flags := cmd.Flags()
+	if !flags.Changed("code") && !flags.Changed("description") {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrProjectNoChanges))
+	}
+
+	repo := database.NewRepository()
+	project, err := loadProject(repo, args[0], projectSetCustomer)
+	if err != nil {
+		return err
+	}
+
+	// An empty value removes the code or the description
+	if flags.Changed("code") {
+		code, err := projectCode(repo, projectSetCode, project.ID)
+		if err != nil {
+			return err
+		}
+		project.Code = code
+	}
+	if flags.Changed("description") {
+		project.Description = strings.TrimSpace(projectSetDescription)
+	}
+
+	if err := repo.UpdateProject(project); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrUpdateProject), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyProjectSetSuccess)+"\n", project.Name, project.Customer.Name)
+
+	return nil
+}
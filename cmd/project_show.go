@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var projectShowCustomer string
+
+var projectShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProjectShow,
+}
+
+func init() {
+	projectCmd.AddCommand(projectShowCmd)
+
+	projectShowCmd.Flags().StringVarP(&projectShowCustomer, "customer", "c", "", "")
+}
+
+func localizeProjectShowCommand() {
+	projectShowCmd.Short = i18n.T(i18n.KeyProjectShowShort)
+	projectShowCmd.Long = i18n.T(i18n.KeyProjectShowLong)
+
+	projectShowCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyProjectFlagCustomer)
+}
+
+func runProjectShow(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	project, err := loadProject(repo, args[0], projectShowCustomer)
+	if err != nil {
+		return err
+	}
+
+	activity, err := repo.ProjectActivity()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	return output.FormatProject(*project, activity[project.ID], os.Stdout)
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/dateparse"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var rateCmd = &cobra.Command{
+	Use:   "rate",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+}
+
+func init() {
+	rootCmd.AddCommand(rateCmd)
+}
+
+func localizeRateCommand() {
+	rateCmd.Short = i18n.T(i18n.KeyRateShort)
+	rateCmd.Long = i18n.T(i18n.KeyRateLong)
+
+	localizeRateSetCommand()
+	localizeRateListCommand()
+	localizeRateHistoryCommand()
+	localizeRateApplyCommand()
+}
+
+// parseRate parses an hourly rate given on the command line. Every rate entered goes
+// through it: rates are stored in öre, so more decimals are refused instead of being
+// rounded after the user has seen them, see models.Money.IsRate.
+func parseRate(value string) (models.Money, error) {
+	rate, err := models.ParseMoney(value)
+	if err != nil || !rate.IsRate() {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidRate), value)
+	}
+	return rate, nil
+}
+
+// parseOptionalRate is parseRate for flags where an empty value or 0 means no rate
+func parseOptionalRate(value string) (models.Money, error) {
+	if rate, err := models.ParseMoney(value); strings.TrimSpace(value) == "" || (err == nil && rate == 0) {
+		return 0, nil
+	}
+	return parseRate(value)
+}
+
+// configuredRate returns the default rate of the configuration, refusing one that was
+// edited by hand into more than two decimals. It is 0 when no default rate is set.
+func configuredRate(cfg *config.Config) (models.Money, error) {
+	rate := models.NewMoney(cfg.DefaultRate)
+	if rate != 0 && !rate.IsRate() {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidRate), rate.Exact())
+	}
+	return rate, nil
+}
+
+// rateScope looks up the consultant, customer and project a rate card applies to and
+// returns a card with that scope. A project card refers to the project alone, since the
+// project belongs to the customer; the project is given by its code or by its name and
+// customer.
+func rateScope(repo *database.Repository, consultantName, customerName, projectName string) (*models.RateCard, error) {
+	if consultantName == "" && customerName == "" && projectName == "" {
+		return nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrRateScopeRequired))
+	}
+
+	card := &models.RateCard{}
+	if consultantName != "" {
+		consultant, err := repo.GetConsultantByName(consultantName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchRateCards), err)
+		}
+		if consultant == nil {
+			return nil, fmt.Errorf(i18n.T(i18n.KeyErrConsultantNotFound), consultantName)
+		}
+		card.ConsultantID, card.Consultant = &consultant.ID, consultant
+	}
+	if projectName != "" {
+		project, err := loadProject(repo, projectName, customerName)
+		if err != nil {
+			return nil, err
+		}
+		card.ProjectID, card.Project = &project.ID, project
+	} else if customerName != "" {
+		customer, err := loadCustomer(repo, customerName)
+		if err != nil {
+			return nil, err
+		}
+		card.CustomerID, card.Customer = &customer.ID, customer
+	}
+	return card, nil
+}
+
+// describeRateScope describes who a rate card applies to, e.g.
+// "consultant Anna, project Backend (Acme)". The associations of the card must be loaded.
+func describeRateScope(card *models.RateCard) string {
+	var parts []string
+	if card.Consultant != nil {
+		parts = append(parts, fmt.Sprintf(i18n.T(i18n.KeyRateScopeConsultant), card.Consultant.Name))
+	}
+	if card.Customer != nil {
+		parts = append(parts, fmt.Sprintf(i18n.T(i18n.KeyRateScopeCustomer), card.Customer.Name))
+	}
+	if card.Project != nil {
+		parts = append(parts, fmt.Sprintf(i18n.T(i18n.KeyRateScopeProject), card.Project.Name, card.Project.Customer.Name))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// rateDate parses the day given to a rate command, or returns today when it is empty
+func rateDate(value string) (time.Time, error) {
+	if value == "" {
+		now := time.Now()
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+	return dateparse.Parse(value, time.Now())
+}
@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rateApplyConsultant string
+	rateApplyCustomer   string
+	rateApplyProject    string
+	rateApplyDates      dateRangeFlags
+	rateApplyRate       string
+	rateApplyMerge      bool
+	rateApplyYes        bool
+	rateApplyDryRun     bool
+)
+
+var rateApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runRateApply,
+}
+
+func init() {
+	rateCmd.AddCommand(rateApplyCmd)
+
+	rateApplyCmd.Flags().StringVarP(&rateApplyConsultant, "consultant", "n", "", "")
+	rateApplyCmd.Flags().StringVarP(&rateApplyCustomer, "customer", "c", "", "")
+	rateApplyCmd.Flags().StringVarP(&rateApplyProject, "project", "p", "", "")
+	addDateRangeFlags(rateApplyCmd, &rateApplyDates)
+	rateApplyCmd.Flags().StringVarP(&rateApplyRate, "rate", "r", "", "")
+	rateApplyCmd.Flags().BoolVar(&rateApplyMerge, "merge", false, "")
+	rateApplyCmd.Flags().BoolVar(&rateApplyYes, "yes", false, "")
+	rateApplyCmd.Flags().BoolVar(&rateApplyDryRun, "dry-run", false, "")
+	rateApplyCmd.MarkFlagRequired("rate")
+}
+
+func localizeRateApplyCommand() {
+	rateApplyCmd.Short = i18n.T(i18n.KeyRateApplyShort)
+	rateApplyCmd.Long = i18n.T(i18n.KeyRateApplyLong)
+
+	rateApplyCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyRateFlagConsultant)
+	rateApplyCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyRateFlagCustomer)
+	rateApplyCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyRateFlagProject)
+	localizeDateRangeFlags(rateApplyCmd)
+	rateApplyCmd.Flags().Lookup("rate").Usage = i18n.T(i18n.KeyRateApplyFlagRate)
+	rateApplyCmd.Flags().Lookup("merge").Usage = i18n.T(i18n.KeyRateApplyFlagMerge)
+	rateApplyCmd.Flags().Lookup("yes").Usage = i18n.T(i18n.KeyRateApplyFlagYes)
+	rateApplyCmd.Flags().Lookup("dry-run").Usage = i18n.T(i18n.KeyRateApplyFlagDryRun)
+}
+
+func runRateApply(cmd *cobra.Command, args []string) error {
+	rate, err := parseRate(rateApplyRate)
+	if err != nil {
+		return err
+	}
+
+	// Without a period every entry of the scope would be re-priced, which is never intended
+	if !rateApplyDates.isSet() {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrRatePeriodRequired))
+	}
+	startDate, endDate, err := rateApplyDates.resolve()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+	rounding := roundingRule(cfg)
+
+	repo := database.NewRepository()
+	scope, err := rateScope(repo, rateApplyConsultant, rateApplyCustomer, rateApplyProject)
+	if err != nil {
+		return err
+	}
+
+	entries, err := repo.GetTimeEntriesByFilters(rateApplyConsultant, rateApplyProject, rateApplyCustomer, startDate, endDate)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	// Invoiced entries are locked and keep their rate
+	var open []models.TimeEntry
+	var changes []output.RateChange
+	skipped := 0
+	for _, entry := range entries {
+		switch {
+		case entry.IsInvoiced():
+			skipped++
+		case entry.HourlyRate == rate:
+			open = append(open, entry)
+		default:
+			open = append(open, entry)
+			changes = append(changes, output.RateChange{Entry: entry, NewRate: rate})
+		}
+	}
+
+	if skipped > 0 {
+		fmt.Printf(i18n.T(i18n.KeyRateApplySkipped)+"\n", skipped)
+	}
+	if len(changes) == 0 {
+		fmt.Println(i18n.T(i18n.KeyRateApplyNoChanges))
+		return nil
+	}
+
+	if err := output.FormatRateChanges(changes, rounding, os.Stdout); err != nil {
+		return err
+	}
+	fmt.Println()
+	fmt.Printf(i18n.T(i18n.KeyRateApplyDelta)+"\n", formatRateDeltas(changes, rounding))
+
+	merges := rateMerges(open, changes)
+	for _, change := range changes {
+		target, ok := merges[change.Entry.ID]
+		if !ok {
+			continue
+		}
+		if !rateApplyMerge {
+			return fmt.Errorf(i18n.T(i18n.KeyErrRateApplyConflict), change.Entry.ID, target)
+		}
+		fmt.Printf(i18n.T(i18n.KeyRateApplyMerge)+"\n", change.Entry.ID, target)
+	}
+
+	if rateApplyDryRun {
+		fmt.Printf(i18n.T(i18n.KeyRateApplyDryRun)+"\n", len(changes))
+		return nil
+	}
+
+	if !rateApplyYes {
+		ok, err := confirm(fmt.Sprintf(i18n.T(i18n.KeyRateApplyConfirm), len(changes)))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println(i18n.T(i18n.KeyRateApplyAborted))
+			return nil
+		}
+	}
+
+	adjustment := &models.RateAdjustment{
+		ConsultantID: scope.ConsultantID,
+		CustomerID:   scope.CustomerID,
+		ProjectID:    scope.ProjectID,
+		HourlyRate:   rate,
+	}
+	if !startDate.IsZero() {
+		adjustment.StartDate = &startDate
+	}
+	if !endDate.IsZero() {
+		lastDay := endDate.AddDate(0, 0, -1)
+		adjustment.EndDate = &lastDay
+	}
+	for _, change := range changes {
+		line := models.RateAdjustmentEntry{
+			TimeEntryID: change.Entry.ID,
+			Hours:       change.Entry.Hours,
+			OldRate:     change.Entry.HourlyRate,
+		}
+		if target, ok := merges[change.Entry.ID]; ok {
+			line.MergedIntoID = &target
+		}
+		adjustment.Entries = append(adjustment.Entries, line)
+	}
+
+	if err := repo.ApplyRateAdjustment(adjustment); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrApplyRate), err)
+	}
+
+	// The rate is in the currency of the customer, when the scope has one
+	amount := rate.String()
+	if customer := scope.BilledCustomer(); customer != nil {
+		amount = currency.Format(rate, customer.CurrencyCode())
+	}
+	fmt.Printf(i18n.T(i18n.KeyRateApplySuccess)+"\n", len(changes), amount)
+
+	return nil
+}
+
+// rateMerges finds the changed entries that become identical to another open entry at the
+// new rate, i.e. share the merge key of FindMatchingTimeEntry, and returns the ID of the
+// entry each is merged into. An entry that already has the new rate is kept, otherwise the
+// oldest of the changed ones. Entries with start and end times are never merged.
+func rateMerges(open []models.TimeEntry, changes []output.RateChange) map[uint]uint {
+	type mergeKey struct {
+		date         string
+		consultantID uint
+		projectID    uint
+		description  string
+	}
+	changed := make(map[uint]bool)
+	for _, change := range changes {
+		changed[change.Entry.ID] = true
+	}
+
+	groups := make(map[mergeKey][]models.TimeEntry)
+	var keys []mergeKey
+	for _, entry := range open {
+		if entry.HasTimes() {
+			continue
+		}
+		key := mergeKey{entry.Date.Format("2006-01-02"), entry.ConsultantID, entry.ProjectID, entry.Description}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], entry)
+	}
+
+	merges := make(map[uint]uint)
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < 2 {
+			continue
+		}
+
+		target := group[0]
+		for _, entry := range group[1:] {
+			if changed[target.ID] && (!changed[entry.ID] || entry.ID < target.ID) {
+				target = entry
+			}
+		}
+
+		for _, entry := range group {
+			if changed[entry.ID] && entry.ID != target.ID {
+				merges[entry.ID] = target.ID
+			}
+		}
+	}
+	return merges
+}
+
+// formatRateDeltas sums the change in cost per currency, e.g. "+1250.00 kr, -80.00 EUR"
+func formatRateDeltas(changes []output.RateChange, rounding models.Rounding) string {
+	deltas := make(map[string]models.Money)
+	for _, change := range changes {
+		deltas[change.Entry.Project.Customer.CurrencyCode()] += change.Delta(rounding)
+	}
+
+	codes := make([]string, 0, len(deltas))
+	for code := range deltas {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	parts := make([]string, len(codes))
+	for i, code := range codes {
+		parts[i] = currency.Format(deltas[code], code)
+		if deltas[code].Round() > 0 {
+			parts[i] = "+" + parts[i]
+		}
+	}
+	return strings.Join(parts, ", ")
+}
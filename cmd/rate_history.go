@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rateHistoryConsultant string
+	rateHistoryCustomer   string
+	rateHistoryProject    string
+)
+
+var rateHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runRateHistory,
+}
+
+func init() {
+	rateCmd.AddCommand(rateHistoryCmd)
+
+	rateHistoryCmd.Flags().StringVarP(&rateHistoryConsultant, "consultant", "n", "", "")
+	rateHistoryCmd.Flags().StringVarP(&rateHistoryCustomer, "customer", "c", "", "")
+	rateHistoryCmd.Flags().StringVarP(&rateHistoryProject, "project", "p", "", "")
+}
+
+func localizeRateHistoryCommand() {
+	rateHistoryCmd.Short = i18n.T(i18n.KeyRateHistoryShort)
+	rateHistoryCmd.Long = i18n.T(i18n.KeyRateHistoryLong)
+
+	rateHistoryCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyRateFlagConsultant)
+	rateHistoryCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyRateFlagCustomer)
+	rateHistoryCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyRateFlagProject)
+}
+
+func runRateHistory(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+	// A zero date keeps every card, whatever its validity
+	cards, err := repo.GetRateCards(rateHistoryConsultant, rateHistoryCustomer, rateHistoryProject, time.Time{})
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchRateCards), err)
+	}
+
+	if len(cards) == 0 {
+		fmt.Println(i18n.T(i18n.KeyRateNoResults))
+		return nil
+	}
+
+	return output.FormatRateCards(cards, os.Stdout)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rateListConsultant string
+	rateListCustomer   string
+	rateListProject    string
+	rateListDate       string
+)
+
+var rateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runRateList,
+}
+
+func init() {
+	rateCmd.AddCommand(rateListCmd)
+
+	rateListCmd.Flags().StringVarP(&rateListConsultant, "consultant", "n", "", "")
+	rateListCmd.Flags().StringVarP(&rateListCustomer, "customer", "c", "", "")
+	rateListCmd.Flags().StringVarP(&rateListProject, "project", "p", "", "")
+	rateListCmd.Flags().StringVarP(&rateListDate, "date", "D", "", "")
+}
+
+func localizeRateListCommand() {
+	rateListCmd.Short = i18n.T(i18n.KeyRateListShort)
+	rateListCmd.Long = i18n.T(i18n.KeyRateListLong)
+
+	rateListCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyRateFlagConsultant)
+	rateListCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyRateFlagCustomer)
+	rateListCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyRateFlagProject)
+	rateListCmd.Flags().Lookup("date").Usage = i18n.T(i18n.KeyRateListFlagDate)
+}
+
+func runRateList(cmd *cobra.Command, args []string) error {
+	date, err := rateDate(rateListDate)
+	if err != nil {
+		return err
+	}
+
+	repo := database.NewRepository()
+	cards, err := repo.GetRateCards(rateListConsultant, rateListCustomer, rateListProject, date)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchRateCards), err)
+	}
+
+	if len(cards) == 0 {
+		fmt.Println(i18n.T(i18n.KeyRateNoResults))
+		return nil
+	}
+
+	return output.FormatRateCards(cards, os.Stdout)
+}
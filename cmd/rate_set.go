@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rateSetConsultant string
+	rateSetCustomer   string
+	rateSetProject    string
+	rateSetFrom       string
+)
+
+var rateSetCmd = &cobra.Command{
+	Use:   "set <rate>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRateSet,
+}
+
+func init() {
+	rateCmd.AddCommand(rateSetCmd)
+
+	rateSetCmd.Flags().StringVarP(&rateSetConsultant, "consultant", "n", "", "")
+	rateSetCmd.Flags().StringVarP(&rateSetCustomer, "customer", "c", "", "")
+	rateSetCmd.Flags().StringVarP(&rateSetProject, "project", "p", "", "")
+	rateSetCmd.Flags().StringVar(&rateSetFrom, "from", "", "")
+}
+
+func localizeRateSetCommand() {
+	rateSetCmd.Short = i18n.T(i18n.KeyRateSetShort)
+	rateSetCmd.Long = i18n.T(i18n.KeyRateSetLong)
+
+	rateSetCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyRateFlagConsultant)
+	rateSetCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyRateFlagCustomer)
+	rateSetCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyRateFlagProject)
+	rateSetCmd.Flags().Lookup("from").Usage = i18n.T(i18n.KeyRateSetFlagFrom)
+}
+
+func runRateSet(cmd *cobra.Command, args []string) error {
+	rate, err := parseRate(args[0])
+	if err != nil {
+		return err
+	}
+
+	from, err := rateDate(rateSetFrom)
+	if err != nil {
+		return err
+	}
+
+	repo := database.NewRepository()
+	card, err := rateScope(repo, rateSetConsultant, rateSetCustomer, rateSetProject)
+	if err != nil {
+		return err
+	}
+	card.HourlyRate = rate
+	card.ValidFrom = from
+
+	scope := describeRateScope(card)
+	if err := repo.SetRateCard(card); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrSaveRateCard), err)
+	}
+
+	amount := rate.String()
+	if customer := card.BilledCustomer(); customer != nil {
+		amount = currency.Format(rate, customer.CurrencyCode())
+	}
+	fmt.Printf(i18n.T(i18n.KeyRateSetSuccess)+"\n", amount, scope, from.Format("2006-01-02"))
+	if card.ValidTo != nil {
+		fmt.Printf(i18n.T(i18n.KeyRateSetUntil)+"\n", card.ValidTo.Format("2006-01-02"))
+	}
+
+	return nil
+}
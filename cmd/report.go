@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+}
+
+func localizeReportCommand() {
+	reportCmd.Short = i18n.T(i18n.KeyReportShort)
+	reportCmd.Long = i18n.T(i18n.KeyReportLong)
+
+	localizeReportTimesheetCommand()
+	localizeReportMonthCommand()
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/LimerDev/worklog/internal/report"
+	"github.com/spf13/cobra"
+)
+
+var (
+	monthReportConsultant string
+	monthReportProject    string
+	monthReportCustomer   string
+	monthReportDates      dateRangeFlags
+	monthReportOutput     string
+	monthReportOutputFile string
+	monthReportCurrency   string
+)
+
+var reportMonthCmd = &cobra.Command{
+	Use:   "month",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runReportMonth,
+}
+
+func init() {
+	reportCmd.AddCommand(reportMonthCmd)
+
+	reportMonthCmd.Flags().StringVarP(&monthReportConsultant, "consultant", "n", "", "")
+	reportMonthCmd.Flags().StringVarP(&monthReportProject, "project", "p", "", "")
+	reportMonthCmd.Flags().StringVarP(&monthReportCustomer, "customer", "c", "", "")
+	addDateRangeFlags(reportMonthCmd, &monthReportDates)
+	reportMonthCmd.Flags().StringVarP(&monthReportOutput, "output", "o", "table", "")
+	reportMonthCmd.Flags().StringVar(&monthReportOutputFile, "output-file", "", "")
+	reportMonthCmd.Flags().StringVar(&monthReportCurrency, "currency", "", "")
+}
+
+func localizeReportMonthCommand() {
+	reportMonthCmd.Short = i18n.T(i18n.KeyReportMonthShort)
+	reportMonthCmd.Long = i18n.T(i18n.KeyReportMonthLong)
+
+	reportMonthCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyGetFlagConsultant)
+	reportMonthCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyGetFlagProject)
+	reportMonthCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyGetFlagCustomer)
+	localizeDateRangeFlags(reportMonthCmd)
+	reportMonthCmd.Flags().Lookup("output").Usage = i18n.T(i18n.KeyReportFlagOutput)
+	reportMonthCmd.Flags().Lookup("output-file").Usage = i18n.T(i18n.KeyGetFlagOutputFile)
+	reportMonthCmd.Flags().Lookup("currency").Usage = i18n.T(i18n.KeyReportFlagCurrency)
+}
+
+func runReportMonth(cmd *cobra.Command, args []string) error {
+	startDate, endDate, err := monthReportDates.resolve()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+	converter, err := loadConverter(cfg, monthReportCurrency)
+	if err != nil {
+		return err
+	}
+
+	// The database sums the entries, so they are never loaded one by one
+	repo := database.NewRepository()
+	summaries, err := repo.SumTimeEntriesByCustomer(monthReportConsultant, monthReportProject, monthReportCustomer, startDate, endDate, roundingRule(cfg))
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	if len(summaries) == 0 {
+		fmt.Println(i18n.T(i18n.KeyGetNoResults))
+		return nil
+	}
+
+	monthReport, err := report.BuildMonthReport(summaries, startDate, endDate, cfg.Invoice.VATRate, converter)
+	if err != nil {
+		return err
+	}
+
+	writer := os.Stdout
+	if monthReportOutputFile != "" {
+		f, err := os.Create(monthReportOutputFile)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCreateOutputFile), err)
+		}
+		defer f.Close()
+		writer = f
+	}
+
+	if err := report.WriteMonthReport(monthReport, output.Format(monthReportOutput), writer); err != nil {
+		return err
+	}
+
+	if monthReportOutputFile != "" {
+		fmt.Printf(i18n.T(i18n.KeyReportSuccess)+"\n", monthReportOutputFile)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/LimerDev/worklog/internal/period"
+	"github.com/LimerDev/worklog/internal/report"
+	"github.com/spf13/cobra"
+)
+
+var (
+	timesheetWeek          int
+	timesheetYear          int
+	timesheetConsultant    string
+	timesheetProject       string
+	timesheetCustomer      string
+	timesheetTeam          bool
+	timesheetExpectedHours float64
+	timesheetOutput        string
+	timesheetOutputFile    string
+)
+
+var reportTimesheetCmd = &cobra.Command{
+	Use:   "timesheet",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runReportTimesheet,
+}
+
+func init() {
+	reportCmd.AddCommand(reportTimesheetCmd)
+
+	reportTimesheetCmd.Flags().IntVarP(&timesheetWeek, "week", "w", 0, "")
+	reportTimesheetCmd.Flags().IntVarP(&timesheetYear, "year", "y", 0, "")
+	reportTimesheetCmd.Flags().StringVarP(&timesheetConsultant, "consultant", "n", "", "")
+	reportTimesheetCmd.Flags().StringVarP(&timesheetProject, "project", "p", "", "")
+	reportTimesheetCmd.Flags().StringVarP(&timesheetCustomer, "customer", "c", "", "")
+	reportTimesheetCmd.Flags().BoolVar(&timesheetTeam, "team", false, "")
+	reportTimesheetCmd.Flags().Float64Var(&timesheetExpectedHours, "expected-hours", 0, "")
+	reportTimesheetCmd.Flags().StringVarP(&timesheetOutput, "output", "o", "table", "")
+	reportTimesheetCmd.Flags().StringVar(&timesheetOutputFile, "output-file", "", "")
+}
+
+func localizeReportTimesheetCommand() {
+	reportTimesheetCmd.Short = i18n.T(i18n.KeyReportTimesheetShort)
+	reportTimesheetCmd.Long = i18n.T(i18n.KeyReportTimesheetLong)
+
+	reportTimesheetCmd.Flags().Lookup("week").Usage = i18n.T(i18n.KeyReportFlagWeek)
+	reportTimesheetCmd.Flags().Lookup("year").Usage = i18n.T(i18n.KeyReportFlagYear)
+	reportTimesheetCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyGetFlagConsultant)
+	reportTimesheetCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyGetFlagProject)
+	reportTimesheetCmd.Flags().Lookup("customer").Usage = i18n.T(i18n.KeyGetFlagCustomer)
+	reportTimesheetCmd.Flags().Lookup("team").Usage = i18n.T(i18n.KeyReportFlagTeam)
+	reportTimesheetCmd.Flags().Lookup("expected-hours").Usage = i18n.T(i18n.KeyReportFlagExpectedHours)
+	reportTimesheetCmd.Flags().Lookup("output").Usage = i18n.T(i18n.KeyReportFlagOutput)
+	reportTimesheetCmd.Flags().Lookup("output-file").Usage = i18n.T(i18n.KeyGetFlagOutputFile)
+}
+
+func runReportTimesheet(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+
+	expectedHours := cfg.ExpectedDailyHours
+	if cmd.Flags().Changed("expected-hours") {
+		expectedHours = timesheetExpectedHours
+	}
+	if expectedHours < 0 || expectedHours > 24 {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvalidDailyHours))
+	}
+
+	// Default to the current ISO week, whose year may differ from the calendar year around New Year
+	year, week := timesheetYear, timesheetWeek
+	if week == 0 {
+		currentYear, currentWeek := time.Now().ISOWeek()
+		week = currentWeek
+		if year == 0 {
+			year = currentYear
+		}
+	}
+	if year == 0 {
+		year = time.Now().Year()
+	}
+
+	startDate, endDate, err := period.Spec{Week: week, Year: year}.Resolve(time.Now())
+	if err != nil {
+		return err
+	}
+
+	repo := database.NewRepository()
+	entries, err := repo.GetTimeEntriesByFilters(timesheetConsultant, timesheetProject, timesheetCustomer, startDate, endDate)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchWorkLogs), err)
+	}
+
+	if len(entries) == 0 {
+		fmt.Println(i18n.T(i18n.KeyGetNoResults))
+		return nil
+	}
+
+	writer := os.Stdout
+	if timesheetOutputFile != "" {
+		f, err := os.Create(timesheetOutputFile)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrCreateOutputFile), err)
+		}
+		defer f.Close()
+		writer = f
+	}
+
+	timesheet := report.BuildTimesheet(entries, year, week, startDate, expectedHours, timesheetTeam)
+	// --expected-hours applies to everyone, otherwise consultants with a weekly capacity have their own
+	if !cmd.Flags().Changed("expected-hours") {
+		timesheet.ConsultantDailyHours = report.ConsultantDailyHours(entries)
+	}
+	if err := report.WriteTimesheet(timesheet, output.Format(timesheetOutput), writer); err != nil {
+		return err
+	}
+
+	if timesheetOutputFile != "" {
+		fmt.Printf(i18n.T(i18n.KeyExportSuccess)+"\n", len(entries), timesheetOutputFile)
+	}
+
+	return nil
+}
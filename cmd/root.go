@@ -17,6 +17,12 @@ var rootCmd = &cobra.Command{
 }
 
 func Execute() {
+	// Localize commands here rather than in init: commands defined in files sorting
+	// after root.go register themselves after its init has run
+	for _, c := range rootCmd.Commands() {
+		localizeCommand(c)
+	}
+
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -32,10 +38,6 @@ func init() {
 	rootCmd.Short = i18n.T(i18n.KeyRootShort)
 	rootCmd.Long = i18n.T(i18n.KeyRootLong)
 
-	for _, c := range rootCmd.Commands() {
-		localizeCommand(c)
-	}
-
 	rootCmd.PersistentPreRunE = persistentPreRun
 	initialized = true
 }
@@ -95,6 +97,34 @@ func localizeCommand(cmd *cobra.Command) {
 		localizeAddCommand()
 	case "get":
 		localizeGetCommand()
+	case "edit":
+		localizeEditCommand()
+	case "delete":
+		localizeDeleteCommand()
+	case "show":
+		localizeShowCommand()
+	case "start":
+		localizeStartCommand()
+	case "stop":
+		localizeStopCommand()
+	case "status":
+		localizeStatusCommand()
+	case "cancel":
+		localizeCancelCommand()
+	case "import":
+		localizeImportCommand()
+	case "report":
+		localizeReportCommand()
+	case "invoice":
+		localizeInvoiceCommand()
+	case "customer":
+		localizeCustomerCommand()
+	case "project":
+		localizeProjectCommand()
+	case "consultant":
+		localizeConsultantCommand()
+	case "rate":
+		localizeRateCommand()
 	case "config":
 		localizeConfigCommand()
 	}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var showOutput string
+
+var showCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.ExactArgs(1),
+	RunE:  runShow,
+}
+
+func init() {
+	rootCmd.AddCommand(showCmd)
+
+	showCmd.Flags().StringVarP(&showOutput, "output", "o", "table", "")
+}
+
+func localizeShowCommand() {
+	showCmd.Short = i18n.T(i18n.KeyShowShort)
+	showCmd.Long = i18n.T(i18n.KeyShowLong)
+
+	showCmd.Flags().Lookup("output").Usage = i18n.T(i18n.KeyShowFlagOutput)
+}
+
+func runShow(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+
+	entry, err := loadTimeEntry(repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	formatter := output.GetFormatter(output.Format(showOutput), output.Options{})
+	return formatter.FormatEntry(*entry, os.Stdout)
+}
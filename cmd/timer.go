@@ -0,0 +1,362 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/database"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var (
+	startDescription string
+	startProject     string
+	startClient      string
+	startConsultant  string
+	startRate        string
+
+	timerConsultant  string
+	timerDescription string
+)
+
+var startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runStart,
+}
+
+var stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runStop,
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runStatus,
+}
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "", // Set after i18n initialization
+	Long:  "", // Set after i18n initialization
+	Args:  cobra.NoArgs,
+	RunE:  runCancel,
+}
+
+func init() {
+	rootCmd.AddCommand(startCmd)
+	rootCmd.AddCommand(stopCmd)
+	rootCmd.AddCommand(statusCmd)
+	rootCmd.AddCommand(cancelCmd)
+
+	startCmd.Flags().StringVarP(&startDescription, "description", "d", "", "")
+	startCmd.Flags().StringVarP(&startProject, "project", "p", "", "")
+	startCmd.Flags().StringVarP(&startClient, "client", "c", "", "")
+	startCmd.Flags().StringVarP(&startConsultant, "consultant", "n", "", "")
+	startCmd.Flags().StringVarP(&startRate, "rate", "r", "", "")
+
+	stopCmd.Flags().StringVarP(&timerConsultant, "consultant", "n", "", "")
+	stopCmd.Flags().StringVarP(&timerDescription, "description", "d", "", "")
+	statusCmd.Flags().StringVarP(&timerConsultant, "consultant", "n", "", "")
+	cancelCmd.Flags().StringVarP(&timerConsultant, "consultant", "n", "", "")
+}
+
+func localizeStartCommand() {
+	startCmd.Short = i18n.T(i18n.KeyStartShort)
+	startCmd.Long = i18n.T(i18n.KeyStartLong)
+
+	startCmd.Flags().Lookup("description").Usage = i18n.T(i18n.KeyStartFlagDescription)
+	startCmd.Flags().Lookup("project").Usage = i18n.T(i18n.KeyAddFlagProject)
+	startCmd.Flags().Lookup("client").Usage = i18n.T(i18n.KeyAddFlagClient)
+	startCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyAddFlagConsultant)
+	startCmd.Flags().Lookup("rate").Usage = i18n.T(i18n.KeyAddFlagRate)
+}
+
+func localizeStopCommand() {
+	stopCmd.Short = i18n.T(i18n.KeyStopShort)
+	stopCmd.Long = i18n.T(i18n.KeyStopLong)
+
+	stopCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyTimerFlagConsultant)
+	stopCmd.Flags().Lookup("description").Usage = i18n.T(i18n.KeyStopFlagDescription)
+}
+
+func localizeStatusCommand() {
+	statusCmd.Short = i18n.T(i18n.KeyStatusShort)
+	statusCmd.Long = i18n.T(i18n.KeyStatusLong)
+
+	statusCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyTimerFlagConsultant)
+}
+
+func localizeCancelCommand() {
+	cancelCmd.Short = i18n.T(i18n.KeyCancelShort)
+	cancelCmd.Long = i18n.T(i18n.KeyCancelLong)
+
+	cancelCmd.Flags().Lookup("consultant").Usage = i18n.T(i18n.KeyTimerFlagConsultant)
+}
+
+func runStart(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Get()
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+	}
+
+	rate, err := parseOptionalRate(startRate)
+	if err != nil {
+		return err
+	}
+	target, err := entryTarget{consultant: startConsultant, client: startClient, project: startProject, rate: rate}.withDefaults(cfg)
+	if err != nil {
+		return err
+	}
+
+	repo := database.NewRepository()
+
+	// The timer keeps the rate of the day it starts
+	now := time.Now()
+	target, err = target.withRate(repo, cfg, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return err
+	}
+
+	consultantObj, projectObj, err := target.resolve(repo)
+	if err != nil {
+		return err
+	}
+
+	running, err := repo.GetRunningTimer(consultantObj.ID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchTimer), err)
+	}
+	// A work log needs a description, which stop can be given but start has no room for
+	if running != nil && running.Description == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrSwitchTimerDescription))
+	}
+
+	timer := &models.Timer{
+		ConsultantID: consultantObj.ID,
+		Consultant:   *consultantObj,
+		ProjectID:    projectObj.ID,
+		Project:      *projectObj,
+		Description:  startDescription,
+		HourlyRate:   target.rate,
+		StartedAt:    now,
+	}
+
+	// Switching tasks: the previous timer is stopped in the same transaction the new one starts in
+	var stopped *models.TimeEntry
+	var merged bool
+	err = repo.Transaction(func(tx *database.Repository) error {
+		if running != nil {
+			var err error
+			if stopped, merged, err = stopTimer(tx, running, ""); err != nil {
+				return err
+			}
+		}
+		if err := tx.CreateTimer(timer); err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrStartTimer), err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if running != nil {
+		if err := printStoppedTimer(repo, stopped, merged); err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyTimerStarted)+"\n", timer.StartedAt.Format("15:04"))
+	printTimer(timer)
+
+	return nil
+}
+
+func runStop(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+
+	timer, err := findRunningTimer(repo, timerConsultant)
+	if err != nil {
+		return err
+	}
+	if timer == nil {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrNoRunningTimer))
+	}
+	if timerDescription == "" && timer.Description == "" {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrTimerDescriptionRequired))
+	}
+
+	var entry *models.TimeEntry
+	var merged bool
+	err = repo.Transaction(func(tx *database.Repository) error {
+		var err error
+		entry, merged, err = stopTimer(tx, timer, timerDescription)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return printStoppedTimer(repo, entry, merged)
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+
+	timer, err := findRunningTimer(repo, timerConsultant)
+	if err != nil {
+		return err
+	}
+	if timer == nil {
+		fmt.Println(i18n.T(i18n.KeyTimerNotRunning))
+		return nil
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyTimerRunning)+"\n", timer.StartedAt.In(time.Local).Format("15:04"), formatElapsed(time.Since(timer.StartedAt)))
+	printTimer(timer)
+
+	return nil
+}
+
+func runCancel(cmd *cobra.Command, args []string) error {
+	repo := database.NewRepository()
+
+	timer, err := findRunningTimer(repo, timerConsultant)
+	if err != nil {
+		return err
+	}
+	if timer == nil {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrNoRunningTimer))
+	}
+
+	if err := repo.DeleteTimer(timer.ID); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrStopTimer), err)
+	}
+
+	fmt.Printf(i18n.T(i18n.KeyTimerCancelled)+"\n", formatElapsed(time.Since(timer.StartedAt)))
+
+	return nil
+}
+
+// findRunningTimer returns the running timer of the named consultant, or of the default
+// consultant if name is empty. It returns nil if no timer is running.
+func findRunningTimer(repo *database.Repository, name string) (*models.Timer, error) {
+	if name == "" {
+		cfg, err := config.Get()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadConfig), err)
+		}
+		name = cfg.DefaultConsultant
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%s", i18n.T(i18n.KeyErrConsultantRequired))
+	}
+
+	consultantObj, err := repo.GetConsultantByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchTimer), err)
+	}
+	if consultantObj == nil {
+		return nil, nil
+	}
+
+	timer, err := repo.GetRunningTimer(consultantObj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrFetchTimer), err)
+	}
+	return timer, nil
+}
+
+// stopTimer removes the timer and logs the elapsed time as a time entry dated the day the
+// timer was started. The entry gets the start and stop times rounded to the minute, and hours
+// to match, unless the timer ran past midnight; then only the elapsed hours, rounded to
+// hundredths, are logged. A non-empty description replaces the timer's own; callers make sure
+// one of them is set. It returns nil if no time was logged.
+func stopTimer(repo *database.Repository, timer *models.Timer, description string) (*models.TimeEntry, bool, error) {
+	if description == "" {
+		description = timer.Description
+	}
+
+	if err := repo.DeleteTimer(timer.ID); err != nil {
+		return nil, false, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrStopTimer), err)
+	}
+
+	started := timer.StartedAt.In(time.Local).Round(time.Minute)
+	stopped := time.Now().In(time.Local).Round(time.Minute)
+	date := time.Date(started.Year(), started.Month(), started.Day(), 0, 0, 0, 0, time.UTC)
+
+	var start, end *time.Time
+	hours := math.Round(time.Since(timer.StartedAt).Hours()*100) / 100
+	if stopped.Format("2006-01-02") == started.Format("2006-01-02") {
+		startClock, endClock := clockOn(date, started), clockOn(date, stopped)
+		start, end = &startClock, &endClock
+		hours = endClock.Sub(startClock).Hours()
+	}
+	if hours <= 0 {
+		return nil, false, nil
+	}
+
+	entry := &models.TimeEntry{
+		Date:         date,
+		StartTime:    start,
+		EndTime:      end,
+		Hours:        hours,
+		Description:  description,
+		HourlyRate:   timer.HourlyRate,
+		ProjectID:    timer.ProjectID,
+		Project:      timer.Project,
+		ConsultantID: timer.ConsultantID,
+		Consultant:   timer.Consultant,
+	}
+
+	merged, err := saveTimeEntry(repo, entry)
+	if err != nil {
+		return nil, false, err
+	}
+	return entry, merged, nil
+}
+
+// printStoppedTimer prints the entry a stopped timer was logged as, and warns when its times
+// overlap another entry like `worklog add` does
+func printStoppedTimer(repo *database.Repository, entry *models.TimeEntry, merged bool) error {
+	if entry == nil {
+		fmt.Println(i18n.T(i18n.KeyTimerStoppedEmpty))
+		return nil
+	}
+
+	if merged {
+		fmt.Println(i18n.T(i18n.KeyAddSuccessMerged))
+	} else {
+		fmt.Println(i18n.T(i18n.KeyAddSuccess))
+	}
+	printTimeEntry(entry)
+
+	return warnOverlaps(repo, entry)
+}
+
+func printTimer(timer *models.Timer) {
+	fmt.Printf(i18n.T(i18n.KeyAddOutputConsultant)+"\n", timer.Consultant.Name)
+	fmt.Printf(i18n.T(i18n.KeyAddOutputProject)+"\n", timer.Project.Name)
+	fmt.Printf(i18n.T(i18n.KeyAddOutputCustomer)+"\n", timer.Project.Customer.Name)
+	fmt.Printf(i18n.T(i18n.KeyAddOutputDescription)+"\n", timer.Description)
+}
+
+// formatElapsed formats a duration as hours and minutes, e.g. "1h05m"
+func formatElapsed(d time.Duration) string {
+	minutes := int(d.Round(time.Minute).Minutes())
+	return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+}
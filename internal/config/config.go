@@ -17,6 +17,27 @@ type Config struct {
 	DefaultRate       float64  `mapstructure:"default_rate"`
 	Language          string   `mapstructure:"language"`
 	Database          Database `mapstructure:"database"`
+
+	// FiscalYearStartMonth is the first month (1-12) of the fiscal year; 0 means January
+	FiscalYearStartMonth int `mapstructure:"fiscal_year_start_month"`
+
+	// ExpectedDailyHours is the working time expected per weekday; 0 disables the check
+	ExpectedDailyHours float64 `mapstructure:"expected_daily_hours"`
+
+	Invoice Invoice `mapstructure:"invoice"`
+	Seller  Seller  `mapstructure:"seller"`
+
+	// ExchangeRatesFile holds the exchange rates used to convert totals into one currency;
+	// ~/.worklog/exchange-rates.json unless configured
+	ExchangeRatesFile string `mapstructure:"exchange_rates_file"`
+
+	// Rounding is when costs are rounded to öre: "line" rounds the cost of every time entry
+	// before it is added up, "total" (the default) rounds only the totals
+	Rounding string `mapstructure:"rounding"`
+
+	// Strict refuses to log time against customers that do not exist yet, instead of
+	// creating them; customers are then added with `worklog customer add`
+	Strict bool `mapstructure:"strict"`
 }
 
 // Database holds database configuration
@@ -28,6 +49,39 @@ type Database struct {
 	Name     string `mapstructure:"name"`
 }
 
+// Invoice holds invoice numbering and payment terms
+type Invoice struct {
+	// Prefix starts every invoice number; {year} is replaced by the year of the issue date.
+	// Each distinct prefix is its own number series.
+	Prefix      string `mapstructure:"prefix"`
+	FirstNumber int    `mapstructure:"first_number"` // first number of a new series; 0 means 1
+	Digits      int    `mapstructure:"digits"`       // numbers are zero padded to this width
+	DueDays     int    `mapstructure:"due_days"`     // days from issue to due date; 0 means 30
+
+	// VATRate is the VAT in percent added to the invoiced amount; 25 unless configured
+	VATRate float64 `mapstructure:"vat_rate"`
+}
+
+// Seller holds the details of the invoicing company printed on invoices
+type Seller struct {
+	Name      string `mapstructure:"name"`
+	Address   string `mapstructure:"address"` // one line per row of the postal address
+	OrgNumber string `mapstructure:"org_number"`
+	VATNumber string `mapstructure:"vat_number"`
+	Email     string `mapstructure:"email"`
+	Phone     string `mapstructure:"phone"`
+	Bankgiro  string `mapstructure:"bankgiro"`
+	IBAN      string `mapstructure:"iban"`
+	BIC       string `mapstructure:"bic"`
+}
+
+// DefaultVATRate is the Swedish standard VAT rate in percent
+const DefaultVATRate = 25.0
+
+// DefaultRounding rounds only totals, which is how amounts were computed before the rule
+// could be configured
+const DefaultRounding = "total"
+
 var v *viper.Viper
 
 // Initialize loads configuration from config files
@@ -64,6 +118,30 @@ func Initialize() error {
 	v.BindEnv("default_project")
 	v.BindEnv("default_rate")
 	v.BindEnv("language")
+	v.BindEnv("fiscal_year_start_month")
+	v.BindEnv("expected_daily_hours")
+	v.BindEnv("invoice.prefix")
+	v.BindEnv("invoice.first_number")
+	v.BindEnv("invoice.digits")
+	v.BindEnv("invoice.due_days")
+	v.BindEnv("invoice.vat_rate")
+	v.BindEnv("seller.name")
+	v.BindEnv("seller.address")
+	v.BindEnv("seller.org_number")
+	v.BindEnv("seller.vat_number")
+	v.BindEnv("seller.email")
+	v.BindEnv("seller.phone")
+	v.BindEnv("seller.bankgiro")
+	v.BindEnv("seller.iban")
+	v.BindEnv("seller.bic")
+	v.BindEnv("exchange_rates_file")
+	v.BindEnv("rounding")
+	v.BindEnv("strict")
+
+	// 0 is a valid VAT rate, so the default cannot be expressed as a zero value
+	v.SetDefault("invoice.vat_rate", DefaultVATRate)
+	v.SetDefault("exchange_rates_file", filepath.Join(configDir, "exchange-rates.json"))
+	v.SetDefault("rounding", DefaultRounding)
 
 	return nil
 }
@@ -78,7 +156,7 @@ func Get() (*Config, error) {
 }
 
 // SaveDefaults writes default values to config file
-func SaveDefaults(consultant, client, project string, rate float64, language string) error {
+func SaveDefaults(consultant, client, project string, rate float64, language string, fiscalYearStartMonth int, expectedDailyHours float64) error {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return err
@@ -102,6 +180,12 @@ func SaveDefaults(consultant, client, project string, rate float64, language str
 	if language != "" {
 		v.Set("language", language)
 	}
+	if fiscalYearStartMonth > 0 {
+		v.Set("fiscal_year_start_month", fiscalYearStartMonth)
+	}
+	if expectedDailyHours > 0 {
+		v.Set("expected_daily_hours", expectedDailyHours)
+	}
 
 	// Write to file
 	if err := v.WriteConfigAs(configPath); err != nil {
@@ -111,6 +195,108 @@ func SaveDefaults(consultant, client, project string, rate float64, language str
 	return nil
 }
 
+// SaveInvoiceSettings writes invoice settings to the config file. Empty and zero values are left
+// unchanged, as is the VAT rate when vatRate is nil.
+func SaveInvoiceSettings(prefix string, firstNumber, digits, dueDays int, vatRate *float64) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	configPath := filepath.Join(homeDir, ".worklog", "config.json")
+
+	if prefix != "" {
+		v.Set("invoice.prefix", prefix)
+	}
+	if firstNumber > 0 {
+		v.Set("invoice.first_number", firstNumber)
+	}
+	if digits > 0 {
+		v.Set("invoice.digits", digits)
+	}
+	if dueDays > 0 {
+		v.Set("invoice.due_days", dueDays)
+	}
+	if vatRate != nil {
+		v.Set("invoice.vat_rate", *vatRate)
+	}
+
+	if err := v.WriteConfigAs(configPath); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SaveRounding writes the rounding rule to the config file
+func SaveRounding(rule string) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	configPath := filepath.Join(homeDir, ".worklog", "config.json")
+
+	v.Set("rounding", rule)
+
+	if err := v.WriteConfigAs(configPath); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SaveStrict writes whether new customers must be added before time is logged against them
+func SaveStrict(strict bool) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	configPath := filepath.Join(homeDir, ".worklog", "config.json")
+
+	v.Set("strict", strict)
+
+	if err := v.WriteConfigAs(configPath); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SaveSeller writes the seller details to the config file. Empty values are left unchanged.
+func SaveSeller(seller Seller) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	configPath := filepath.Join(homeDir, ".worklog", "config.json")
+
+	values := map[string]string{
+		"seller.name":       seller.Name,
+		"seller.address":    seller.Address,
+		"seller.org_number": seller.OrgNumber,
+		"seller.vat_number": seller.VATNumber,
+		"seller.email":      seller.Email,
+		"seller.phone":      seller.Phone,
+		"seller.bankgiro":   seller.Bankgiro,
+		"seller.iban":       seller.IBAN,
+		"seller.bic":        seller.BIC,
+	}
+	for key, value := range values {
+		if value != "" {
+			v.Set(key, value)
+		}
+	}
+
+	if err := v.WriteConfigAs(configPath); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
 // ClearDefaults removes defaults from config file
 func ClearDefaults() error {
 	homeDir, err := os.UserHomeDir()
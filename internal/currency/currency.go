@@ -0,0 +1,132 @@
+package currency
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// Default is the currency of customers that have none set
+const Default = models.DefaultCurrency
+
+// ISO 4217 codes: three letters
+var codePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Parse validates a currency code typed on the command line and returns it in upper case
+func Parse(value string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(value))
+	if !codePattern.MatchString(code) {
+		return "", fmt.Errorf(i18n.T(i18n.KeyErrInvalidCurrency), value)
+	}
+	return code, nil
+}
+
+// Symbol returns what is written after an amount: "kr" for Swedish kronor, which is how
+// amounts have always been shown, and the code for any other currency
+func Symbol(code string) string {
+	if code == "" || code == Default {
+		return "kr"
+	}
+	return code
+}
+
+// Format formats an amount rounded to two decimals followed by its currency, e.g.
+// "1250.00 kr" or "980.00 EUR"
+func Format(amount models.Money, code string) string {
+	return amount.String() + " " + Symbol(code)
+}
+
+// Rates is a locally maintained table of exchange rates. Each rate is the price of one
+// unit of the currency in the base currency, e.g. {"base": "SEK", "rates": {"EUR": 11.5}}.
+type Rates struct {
+	Base  string             `json:"base"`
+	Date  string             `json:"date,omitempty"` // when the rates were taken, for reference
+	Rates map[string]float64 `json:"rates"`
+}
+
+// LoadRates reads an exchange rate file
+func LoadRates(path string) (*Rates, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadExchangeRates), err)
+	}
+
+	var rates Rates
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadExchangeRates), err)
+	}
+
+	if rates.Base, err = Parse(rates.Base); err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadExchangeRates), err)
+	}
+	normalized := make(map[string]float64, len(rates.Rates))
+	for code, rate := range rates.Rates {
+		parsed, err := Parse(code)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrReadExchangeRates), err)
+		}
+		if rate <= 0 {
+			return nil, fmt.Errorf(i18n.T(i18n.KeyErrInvalidExchangeRate), code)
+		}
+		normalized[parsed] = rate
+	}
+	rates.Rates = normalized
+
+	return &rates, nil
+}
+
+// rate returns the price of one unit of the currency in the base currency
+func (r *Rates) rate(code string) (float64, error) {
+	if code == "" {
+		code = Default
+	}
+	if code == r.Base {
+		return 1, nil
+	}
+	rate, ok := r.Rates[code]
+	if !ok {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrNoExchangeRate), code)
+	}
+	return rate, nil
+}
+
+// Converter converts amounts into one currency, for totals over customers billed in
+// different currencies
+type Converter struct {
+	To    string
+	rates *Rates
+}
+
+// NewConverter returns a converter into the given currency, which must be in the rates
+func NewConverter(rates *Rates, to string) (*Converter, error) {
+	if _, err := rates.rate(to); err != nil {
+		return nil, err
+	}
+	return &Converter{To: to, rates: rates}, nil
+}
+
+// Convert converts an amount from the given currency, rounded to cents. Exchange rates are
+// not exact, so neither is the conversion before it is rounded.
+func (c *Converter) Convert(amount models.Money, from string) (models.Money, error) {
+	if from == "" {
+		from = Default
+	}
+	if from == c.To {
+		return amount, nil
+	}
+	fromRate, err := c.rates.rate(from)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := c.rates.rate(c.To)
+	if err != nil {
+		return 0, err
+	}
+	return models.NewMoney(math.Round(amount.Float64()*fromRate/toRate*100) / 100), nil
+}
@@ -58,5 +58,11 @@ func AutoMigrate() error {
 		&models.Project{},
 		&models.Consultant{},
 		&models.TimeEntry{},
+		&models.Invoice{},
+		&models.Timer{},
+		&models.ImportRecord{},
+		&models.RateCard{},
+		&models.RateAdjustment{},
+		&models.RateAdjustmentEntry{},
 	)
 }
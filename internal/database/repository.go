@@ -1,10 +1,12 @@
 package database
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/LimerDev/worklog/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -15,17 +17,28 @@ func NewRepository() *Repository {
 	return &Repository{db: DB}
 }
 
+// Transaction runs fn with a repository bound to a single database transaction.
+// The transaction is rolled back if fn returns an error.
+func (r *Repository) Transaction(fn func(tx *Repository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&Repository{db: tx})
+	})
+}
+
+// CreateTimeEntry inserts a new time entry. Loaded associations are not written.
 func (r *Repository) CreateTimeEntry(entry *models.TimeEntry) error {
-	return r.db.Create(entry).Error
+	return r.db.Omit(clause.Associations).Create(entry).Error
 }
 
-// FindMatchingTimeEntry finds an existing time entry that matches all fields except Hours
-func (r *Repository) FindMatchingTimeEntry(date time.Time, consultantID uint, projectID uint, description string, hourlyRate float64) (*models.TimeEntry, error) {
+// FindMatchingTimeEntry finds an existing time entry that matches all fields except Hours.
+// Entries with start and end times are never matched, since merging would lose their interval,
+// and neither are invoiced entries, which are locked.
+func (r *Repository) FindMatchingTimeEntry(date time.Time, consultantID uint, projectID uint, description string, hourlyRate models.Money) (*models.TimeEntry, error) {
 	var entry models.TimeEntry
 	// Normalize date to just the date part (ignore time)
 	dateOnly := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
 
-	err := r.db.Where("date = ? AND consultant_id = ? AND project_id = ? AND description = ? AND hourly_rate = ?",
+	err := r.db.Where("date = ? AND consultant_id = ? AND project_id = ? AND description = ? AND hourly_rate = ? AND start_time IS NULL AND invoice_id IS NULL",
 		dateOnly, consultantID, projectID, description, hourlyRate).
 		First(&entry).Error
 
@@ -35,10 +48,65 @@ func (r *Repository) FindMatchingTimeEntry(date time.Time, consultantID uint, pr
 	return &entry, err
 }
 
-// UpdateTimeEntryHours adds hours to an existing time entry
+// FindConflictingTimeEntry finds another time entry with the same merge key as entry,
+// i.e. one that FindMatchingTimeEntry would merge entry into
+func (r *Repository) FindConflictingTimeEntry(entry *models.TimeEntry) (*models.TimeEntry, error) {
+	var conflict models.TimeEntry
+	dateOnly := time.Date(entry.Date.Year(), entry.Date.Month(), entry.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+	err := r.db.Where("id <> ? AND date = ? AND consultant_id = ? AND project_id = ? AND description = ? AND hourly_rate = ? AND start_time IS NULL AND invoice_id IS NULL",
+		entry.ID, dateOnly, entry.ConsultantID, entry.ProjectID, entry.Description, entry.HourlyRate).
+		First(&conflict).Error
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &conflict, err
+}
+
+// FindOverlappingTimeEntries returns the other entries of the same consultant whose
+// start and end times overlap those of entry
+func (r *Repository) FindOverlappingTimeEntries(entry *models.TimeEntry) ([]models.TimeEntry, error) {
+	var entries []models.TimeEntry
+	if !entry.HasTimes() {
+		return entries, nil
+	}
+
+	err := r.db.Preload("Project.Customer").
+		Where("id <> ? AND consultant_id = ? AND start_time < ? AND end_time > ?",
+			entry.ID, entry.ConsultantID, *entry.EndTime, *entry.StartTime).
+		Order("start_time").
+		Find(&entries).Error
+	return entries, err
+}
+
+// GetTimeEntryByID returns the time entry with its project, customer and consultant,
+// or nil if no entry has the given ID
+func (r *Repository) GetTimeEntryByID(id uint) (*models.TimeEntry, error) {
+	var entry models.TimeEntry
+	err := r.db.Preload("Project.Customer").Preload("Consultant").First(&entry, id).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &entry, err
+}
+
+// UpdateTimeEntry saves all fields of an existing time entry without touching its associations
+func (r *Repository) UpdateTimeEntry(entry *models.TimeEntry) error {
+	return r.db.Omit(clause.Associations).Save(entry).Error
+}
+
+// UpdateTimeEntryHours adds hours to an existing time entry that is not invoiced
 func (r *Repository) UpdateTimeEntryHours(id uint, additionalHours float64) error {
-	return r.db.Model(&models.TimeEntry{}).Where("id = ?", id).
-		UpdateColumn("hours", gorm.Expr("hours + ?", additionalHours)).Error
+	result := r.db.Model(&models.TimeEntry{}).Where("id = ? AND invoice_id IS NULL", id).
+		UpdateColumn("hours", gorm.Expr("hours + ?", additionalHours))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != 1 {
+		return fmt.Errorf("time entry %d does not exist or is invoiced", id)
+	}
+	return nil
 }
 
 func (r *Repository) GetTimeEntriesByMonth(year int, month time.Month) ([]models.TimeEntry, error) {
@@ -49,7 +117,7 @@ func (r *Repository) GetTimeEntriesByMonth(year int, month time.Month) ([]models
 
 	err := r.db.Preload("Project.Customer").Preload("Consultant").
 		Where("date >= ? AND date < ?", startDate, endDate).
-		Order("date asc").
+		Order("date asc, start_time asc").
 		Find(&entries).Error
 
 	return entries, err
@@ -65,37 +133,94 @@ func (r *Repository) GetAllTimeEntries() ([]models.TimeEntry, error) {
 func (r *Repository) GetTimeEntriesByFilters(consultantName, projectName, customerName string, startDate, endDate time.Time) ([]models.TimeEntry, error) {
 	var entries []models.TimeEntry
 	query := r.db.Preload("Project.Customer").Preload("Consultant")
+	query = filterTimeEntries(query, consultantName, projectName, customerName, startDate, endDate)
 
+	err := query.Order("date asc, start_time asc").Find(&entries).Error
+	return entries, err
+}
+
+// SumTimeEntriesByCustomer totals the filtered work logs in the database per customer, project,
+// consultant and hourly rate, ordered by those columns. Amounts are summed as numeric, exactly,
+// with the cost of each entry rounded to öre first under models.RoundPerLine.
+func (r *Repository) SumTimeEntriesByCustomer(consultantName, projectName, customerName string, startDate, endDate time.Time, rounding models.Rounding) ([]models.HoursSummary, error) {
+	// Hours are stored as double precision; like models.Cost, take them with two decimals
+	cost := "ROUND(time_entries.hours::numeric, 2) * time_entries.hourly_rate"
+	if rounding == models.RoundPerLine {
+		cost = "ROUND(" + cost + ", 2)"
+	}
+
+	var summaries []models.HoursSummary
+	query := r.db.Model(&models.TimeEntry{}).
+		Select("customers.name AS customer, projects.name AS project, consultants.name AS consultant, " +
+			"time_entries.hourly_rate AS hourly_rate, COUNT(*) AS entries, " +
+			"SUM(time_entries.hours) AS hours, SUM(" + cost + ") AS amount, " +
+			"customers.currency AS currency, customers.vat_rate AS vat_rate, customers.reverse_charge AS reverse_charge").
+		Joins("JOIN projects ON projects.id = time_entries.project_id").
+		Joins("JOIN customers ON customers.id = projects.customer_id").
+		Joins("JOIN consultants ON consultants.id = time_entries.consultant_id")
+	query = filterTimeEntries(query, consultantName, projectName, customerName, startDate, endDate)
+
+	err := query.Group("customers.name, customers.currency, customers.vat_rate, customers.reverse_charge, projects.name, consultants.name, time_entries.hourly_rate").
+		Order("customers.name, projects.name, consultants.name, time_entries.hourly_rate").
+		Scan(&summaries).Error
+	return summaries, err
+}
+
+// filterTimeEntries restricts a query on time_entries by names and the half-open date range [startDate, endDate).
+// Empty names and zero dates do not filter.
+func filterTimeEntries(query *gorm.DB, consultantName, projectName, customerName string, startDate, endDate time.Time) *gorm.DB {
 	// Filter by consultant
 	if consultantName != "" {
-		query = query.Where("consultant_id IN (SELECT id FROM consultants WHERE name = ?)", consultantName)
+		query = query.Where("time_entries.consultant_id IN (SELECT id FROM consultants WHERE name = ?)", consultantName)
 	}
 
-	// Filter by project
+	// Filter by project, given by name or code
 	if projectName != "" {
-		query = query.Where("project_id IN (SELECT id FROM projects WHERE name = ?)", projectName)
+		query = query.Where("time_entries.project_id IN (SELECT id FROM projects WHERE name = ? OR code = UPPER(?))", projectName, projectName)
 	}
 
 	// Filter by customer
 	if customerName != "" {
-		query = query.Where("project_id IN (SELECT p.id FROM projects p JOIN customers c ON c.id = p.customer_id WHERE c.name = ?)", customerName)
+		query = query.Where("time_entries.project_id IN (SELECT p.id FROM projects p JOIN customers c ON c.id = p.customer_id WHERE c.name = ?)", customerName)
 	}
 
 	// Filter by date range
 	if !startDate.IsZero() && !endDate.IsZero() {
-		query = query.Where("date >= ? AND date < ?", startDate, endDate)
+		query = query.Where("time_entries.date >= ? AND time_entries.date < ?", startDate, endDate)
 	} else if !startDate.IsZero() {
-		query = query.Where("date >= ?", startDate)
+		query = query.Where("time_entries.date >= ?", startDate)
 	} else if !endDate.IsZero() {
-		query = query.Where("date < ?", endDate)
+		query = query.Where("time_entries.date < ?", endDate)
 	}
 
-	err := query.Order("date asc").Find(&entries).Error
-	return entries, err
+	return query
 }
 
+// DeleteTimeEntry deletes a time entry. It fails if the entry does not exist or is invoiced.
 func (r *Repository) DeleteTimeEntry(id uint) error {
-	return r.db.Delete(&models.TimeEntry{}, id).Error
+	result := r.db.Where("invoice_id IS NULL").Delete(&models.TimeEntry{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != 1 {
+		return fmt.Errorf("time entry %d does not exist or is invoiced", id)
+	}
+	return nil
+}
+
+// DeleteTimeEntries deletes the given time entries in one transaction.
+// Nothing is deleted unless every entry exists and none is invoiced.
+func (r *Repository) DeleteTimeEntries(ids []uint) error {
+	return r.Transaction(func(tx *Repository) error {
+		result := tx.db.Where("invoice_id IS NULL").Delete(&models.TimeEntry{}, ids)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(ids)) {
+			return fmt.Errorf("expected to delete %d time entries, deleted %d", len(ids), result.RowsAffected)
+		}
+		return nil
+	})
 }
 
 // Customer methods
@@ -114,15 +239,227 @@ func (r *Repository) GetOrCreateCustomer(name string) (*models.Customer, error)
 	return &customer, err
 }
 
+// GetCustomerByName returns the customer with the given name, or nil if there is none
+func (r *Repository) GetCustomerByName(name string) (*models.Customer, error) {
+	var customer models.Customer
+	err := r.db.Where("name = ?", name).First(&customer).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &customer, err
+}
+
+// UpdateCustomer saves all fields of an existing customer without touching its projects
+func (r *Repository) UpdateCustomer(customer *models.Customer) error {
+	return r.db.Omit(clause.Associations).Save(customer).Error
+}
+
+// GetAllCustomers returns every customer, archived ones included, with its projects
 func (r *Repository) GetAllCustomers() ([]models.Customer, error) {
 	var customers []models.Customer
-	err := r.db.Order("name asc").Find(&customers).Error
+	err := r.db.Preload("Projects", func(db *gorm.DB) *gorm.DB {
+		return db.Order("name asc")
+	}).Order("name asc").Find(&customers).Error
 	return customers, err
 }
 
+// FindSimilarCustomer returns another customer whose name equals name apart from case and
+// leading or trailing spaces, such as "hsb " for "HSB", or nil if there is none
+func (r *Repository) FindSimilarCustomer(name string) (*models.Customer, error) {
+	var customer models.Customer
+	err := r.db.Where("LOWER(TRIM(name)) = LOWER(TRIM(?)) AND name <> ?", name, name).First(&customer).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &customer, err
+}
+
+// CustomerActivity totals the time entries of every customer, keyed by customer ID
+func (r *Repository) CustomerActivity() (map[uint]models.Activity, error) {
+	return r.activity("projects.customer_id")
+}
+
+// activity totals the time entries grouped by column, a column of time_entries or of the
+// project of each entry
+func (r *Repository) activity(column string) (map[uint]models.Activity, error) {
+	var rows []struct {
+		ID uint
+		models.Activity
+	}
+	err := r.db.Model(&models.TimeEntry{}).
+		Select(column + " AS id, COUNT(*) AS entries, COALESCE(SUM(time_entries.hours), 0) AS hours, " +
+			"MIN(time_entries.date) AS first_date, MAX(time_entries.date) AS last_date").
+		Joins("JOIN projects ON projects.id = time_entries.project_id").
+		Group(column).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	activity := make(map[uint]models.Activity, len(rows))
+	for _, row := range rows {
+		activity[row.ID] = row.Activity
+	}
+	return activity, nil
+}
+
+// CustomerMerge counts what MergeCustomers did
+type CustomerMerge struct {
+	ProjectsMoved  int
+	ProjectsMerged int
+	EntriesMerged  int
+	// DroppedRateCards were deleted since they overlapped a rate card of the target for the
+	// same consultant. Their consultant, customer and project are loaded.
+	DroppedRateCards []models.RateCard
+}
+
+// MergeCustomers moves everything of the customer from to the customer into and deletes
+// from, in one transaction. Projects move over, except that a project with the name of a
+// project of into is merged into it: its entries move, and those that become identical to
+// an entry there are added to it as `worklog add` would. Invoices move along. Rate cards
+// move too, except those overlapping a rate card of into for the same scope, which then
+// applies; they are deleted and returned.
+func (r *Repository) MergeCustomers(from, into *models.Customer) (CustomerMerge, error) {
+	var result CustomerMerge
+	err := r.Transaction(func(tx *Repository) error {
+		projects, err := tx.GetProjectsByCustomer(from.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, project := range projects {
+			target, err := tx.GetProjectByName(project.Name, into.ID)
+			if err != nil {
+				return err
+			}
+			if target == nil {
+				if err := tx.db.Model(&models.Project{}).Where("id = ?", project.ID).
+					UpdateColumn("customer_id", into.ID).Error; err != nil {
+					return err
+				}
+				result.ProjectsMoved++
+				continue
+			}
+
+			merged, dropped, err := tx.mergeProject(&project, target)
+			if err != nil {
+				return err
+			}
+			result.ProjectsMerged++
+			result.EntriesMerged += merged
+			result.DroppedRateCards = append(result.DroppedRateCards, dropped...)
+		}
+
+		dropped, err := tx.moveRateCards("customer_id", from.ID, into.ID)
+		if err != nil {
+			return err
+		}
+		result.DroppedRateCards = append(result.DroppedRateCards, dropped...)
+		if err := tx.db.Model(&models.Invoice{}).Where("customer_id = ?", from.ID).
+			UpdateColumn("customer_id", into.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.db.Model(&models.RateAdjustment{}).Where("customer_id = ?", from.ID).
+			UpdateColumn("customer_id", into.ID).Error; err != nil {
+			return err
+		}
+		return tx.db.Delete(&models.Customer{}, from.ID).Error
+	})
+	return result, err
+}
+
+// mergeProject moves the entries, timers and rate cards of project to target and deletes
+// project. Entries that match an entry of target except for hours are added to it. It
+// returns the number of entries merged that way and the rate cards dropped, see moveRateCards.
+func (r *Repository) mergeProject(project, target *models.Project) (int, []models.RateCard, error) {
+	var entries []models.TimeEntry
+	if err := r.db.Where("project_id = ?", project.ID).Order("id asc").Find(&entries).Error; err != nil {
+		return 0, nil, err
+	}
+
+	merged := 0
+	for _, entry := range entries {
+		var match *models.TimeEntry
+		if !entry.HasTimes() && !entry.IsInvoiced() {
+			var err error
+			match, err = r.FindMatchingTimeEntry(entry.Date, entry.ConsultantID, target.ID, entry.Description, entry.HourlyRate)
+			if err != nil {
+				return 0, nil, err
+			}
+		}
+
+		if match == nil {
+			if err := r.db.Model(&models.TimeEntry{}).Where("id = ?", entry.ID).
+				UpdateColumn("project_id", target.ID).Error; err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+
+		if err := r.UpdateTimeEntryHours(match.ID, entry.Hours); err != nil {
+			return 0, nil, err
+		}
+		// The import records move along, so importing the entry again is still skipped
+		if err := r.db.Model(&models.ImportRecord{}).Where("time_entry_id = ?", entry.ID).
+			UpdateColumn("time_entry_id", match.ID).Error; err != nil {
+			return 0, nil, err
+		}
+		if err := r.DeleteTimeEntry(entry.ID); err != nil {
+			return 0, nil, err
+		}
+		merged++
+	}
+
+	if err := r.db.Model(&models.Timer{}).Where("project_id = ?", project.ID).
+		UpdateColumn("project_id", target.ID).Error; err != nil {
+		return 0, nil, err
+	}
+	dropped, err := r.moveRateCards("project_id", project.ID, target.ID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := r.db.Model(&models.RateAdjustment{}).Where("project_id = ?", project.ID).
+		UpdateColumn("project_id", target.ID).Error; err != nil {
+		return 0, nil, err
+	}
+	return merged, dropped, r.db.Delete(&models.Project{}, project.ID).Error
+}
+
+// moveRateCards moves the rate cards with column (customer_id or project_id) from to to.
+// A card whose period overlaps a card of to for the same consultant is deleted instead,
+// since the cards of one scope must not overlap; the card of to applies. The deleted cards
+// are returned with their consultant, customer and project loaded.
+func (r *Repository) moveRateCards(column string, from, to uint) ([]models.RateCard, error) {
+	var ids []uint
+	err := r.db.Raw("SELECT c.id FROM rate_cards c WHERE c."+column+" = ? AND EXISTS "+
+		"(SELECT 1 FROM rate_cards t WHERE t."+column+" = ? AND t.consultant_id IS NOT DISTINCT FROM c.consultant_id "+
+		"AND t.valid_from <= COALESCE(c.valid_to, 'infinity') AND c.valid_from <= COALESCE(t.valid_to, 'infinity'))",
+		from, to).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var dropped []models.RateCard
+	if len(ids) > 0 {
+		err = r.db.Preload("Consultant").Preload("Customer").Preload("Project.Customer").
+			Order("valid_from asc").Find(&dropped, ids).Error
+		if err != nil {
+			return nil, err
+		}
+		if err := r.db.Delete(&models.RateCard{}, ids).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return dropped, r.db.Model(&models.RateCard{}).Where(column+" = ?", from).UpdateColumn(column, to).Error
+}
+
+// GetCustomerByID returns the customer with its projects
 func (r *Repository) GetCustomerByID(id uint) (*models.Customer, error) {
 	var customer models.Customer
-	err := r.db.Preload("Projects").First(&customer, id).Error
+	err := r.db.Preload("Projects", func(db *gorm.DB) *gorm.DB {
+		return db.Order("name asc")
+	}).First(&customer, id).Error
 	return &customer, err
 }
 
@@ -142,6 +479,72 @@ func (r *Repository) GetOrCreateProject(name string, customerID uint) (*models.P
 	return &project, err
 }
 
+// GetProjectByName returns the project of a customer with the given name, or nil if there is none
+func (r *Repository) GetProjectByName(name string, customerID uint) (*models.Project, error) {
+	var project models.Project
+	err := r.db.Where("name = ? AND customer_id = ?", name, customerID).First(&project).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &project, err
+}
+
+// GetProjectByCode returns the project with the given code, in any case, with its customer
+// loaded, or nil if there is none
+func (r *Repository) GetProjectByCode(code string) (*models.Project, error) {
+	var project models.Project
+	err := r.db.Preload("Customer").Where("code = UPPER(TRIM(?))", code).First(&project).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &project, err
+}
+
+// GetProjectsByName returns the projects of any customer with the given name, with their
+// customers loaded
+func (r *Repository) GetProjectsByName(name string) ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Preload("Customer").Where("name = ?", name).Order("id asc").Find(&projects).Error
+	return projects, err
+}
+
+// FindSimilarProject returns a project of the customer whose name equals name apart from
+// case and leading or trailing spaces, or nil if there is none
+func (r *Repository) FindSimilarProject(name string, customerID uint) (*models.Project, error) {
+	var project models.Project
+	err := r.db.Where("LOWER(TRIM(name)) = LOWER(TRIM(?)) AND customer_id = ?", name, customerID).First(&project).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &project, err
+}
+
+// UpdateProject saves all fields of an existing project, including the customer it
+// belongs to, without touching its customer or time entries
+func (r *Repository) UpdateProject(project *models.Project) error {
+	return r.db.Omit(clause.Associations).Save(project).Error
+}
+
+// CountInvoicedTimeEntries returns how many time entries of the project are on an invoice
+func (r *Repository) CountInvoicedTimeEntries(projectID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.TimeEntry{}).Where("project_id = ? AND invoice_id IS NOT NULL", projectID).Count(&count).Error
+	return count, err
+}
+
+// CountCustomerTimeEntries returns how many time entries the projects of the customer have
+func (r *Repository) CountCustomerTimeEntries(customerID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.TimeEntry{}).
+		Where("project_id IN (SELECT id FROM projects WHERE customer_id = ?)", customerID).Count(&count).Error
+	return count, err
+}
+
+// ProjectActivity totals the time entries of every project, keyed by project ID
+func (r *Repository) ProjectActivity() (map[uint]models.Activity, error) {
+	return r.activity("time_entries.project_id")
+}
+
 func (r *Repository) GetAllProjects() ([]models.Project, error) {
 	var projects []models.Project
 	err := r.db.Preload("Customer").Order("name asc").Find(&projects).Error
@@ -176,6 +579,37 @@ func (r *Repository) GetOrCreateConsultant(name string) (*models.Consultant, err
 	return &consultant, err
 }
 
+// GetConsultantByName returns the consultant with the given name, or nil if there is none
+func (r *Repository) GetConsultantByName(name string) (*models.Consultant, error) {
+	var consultant models.Consultant
+	err := r.db.Where("name = ?", name).First(&consultant).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &consultant, err
+}
+
+// UpdateConsultant saves all fields of an existing consultant
+func (r *Repository) UpdateConsultant(consultant *models.Consultant) error {
+	return r.db.Omit(clause.Associations).Save(consultant).Error
+}
+
+// FindSimilarConsultant returns another consultant whose name equals name apart from case
+// and leading or trailing spaces, or nil if there is none
+func (r *Repository) FindSimilarConsultant(name string) (*models.Consultant, error) {
+	var consultant models.Consultant
+	err := r.db.Where("LOWER(TRIM(name)) = LOWER(TRIM(?)) AND name <> ?", name, name).First(&consultant).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &consultant, err
+}
+
+// ConsultantActivity totals the time entries of every consultant, keyed by consultant ID
+func (r *Repository) ConsultantActivity() (map[uint]models.Activity, error) {
+	return r.activity("time_entries.consultant_id")
+}
+
 func (r *Repository) GetAllConsultants() ([]models.Consultant, error) {
 	var consultants []models.Consultant
 	err := r.db.Order("name asc").Find(&consultants).Error
@@ -187,3 +621,282 @@ func (r *Repository) GetConsultantByID(id uint) (*models.Consultant, error) {
 	err := r.db.First(&consultant, id).Error
 	return &consultant, err
 }
+
+// Timer methods
+
+func (r *Repository) CreateTimer(timer *models.Timer) error {
+	return r.db.Omit(clause.Associations).Create(timer).Error
+}
+
+// GetRunningTimer returns the consultant's running timer with its project, customer
+// and consultant, or nil if no timer is running
+func (r *Repository) GetRunningTimer(consultantID uint) (*models.Timer, error) {
+	var timer models.Timer
+	err := r.db.Preload("Project.Customer").Preload("Consultant").
+		Where("consultant_id = ?", consultantID).
+		First(&timer).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &timer, err
+}
+
+func (r *Repository) DeleteTimer(id uint) error {
+	return r.db.Delete(&models.Timer{}, id).Error
+}
+
+// Import record methods
+
+// ImportRecordExists reports whether a row with the given hash has been imported before
+func (r *Repository) ImportRecordExists(hash string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.ImportRecord{}).Where("hash = ?", hash).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *Repository) CreateImportRecord(record *models.ImportRecord) error {
+	return r.db.Omit(clause.Associations).Create(record).Error
+}
+
+// Invoice methods
+
+// CreateInvoice inserts an invoice. Loaded associations are not written.
+func (r *Repository) CreateInvoice(invoice *models.Invoice) error {
+	return r.db.Omit(clause.Associations).Create(invoice).Error
+}
+
+// LastInvoiceSequence returns the highest sequence number used in a series, or 0 if the
+// series has no invoices. Voided invoices count, since their numbers are never reused.
+func (r *Repository) LastInvoiceSequence(series string) (int, error) {
+	var last int
+	err := r.db.Model(&models.Invoice{}).Where("series = ?", series).
+		Select("COALESCE(MAX(sequence), 0)").Scan(&last).Error
+	return last, err
+}
+
+// GetUninvoicedTimeEntries returns the entries of a customer in the half-open range
+// [startDate, endDate) that are not on an invoice
+func (r *Repository) GetUninvoicedTimeEntries(customerName string, startDate, endDate time.Time) ([]models.TimeEntry, error) {
+	var entries []models.TimeEntry
+	query := r.db.Preload("Project.Customer").Preload("Consultant").Where("time_entries.invoice_id IS NULL")
+	query = filterTimeEntries(query, "", "", customerName, startDate, endDate)
+
+	err := query.Order("date asc, start_time asc").Find(&entries).Error
+	return entries, err
+}
+
+// AttachTimeEntries puts time entries on an invoice. It fails unless every entry exists
+// and is not on an invoice already.
+func (r *Repository) AttachTimeEntries(invoiceID uint, ids []uint) error {
+	result := r.db.Model(&models.TimeEntry{}).Where("id IN ? AND invoice_id IS NULL", ids).
+		UpdateColumn("invoice_id", invoiceID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != int64(len(ids)) {
+		return fmt.Errorf("expected to invoice %d time entries, invoiced %d", len(ids), result.RowsAffected)
+	}
+	return nil
+}
+
+// GetInvoiceByNumber returns an invoice with its customer and entries, or nil if there is none
+func (r *Repository) GetInvoiceByNumber(number string) (*models.Invoice, error) {
+	var invoice models.Invoice
+	err := r.db.Preload("Customer").
+		Preload("TimeEntries", func(db *gorm.DB) *gorm.DB { return db.Order("date asc, start_time asc") }).
+		Preload("TimeEntries.Project.Customer").Preload("TimeEntries.Consultant").
+		Where("number = ?", number).
+		First(&invoice).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &invoice, err
+}
+
+// GetInvoiceByID returns an invoice with its customer, without entries, or nil if there is none
+func (r *Repository) GetInvoiceByID(id uint) (*models.Invoice, error) {
+	var invoice models.Invoice
+	err := r.db.Preload("Customer").First(&invoice, id).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &invoice, err
+}
+
+// GetInvoices lists invoices with their customer and entries, optionally filtered by
+// customer name and status, in the order they were numbered
+func (r *Repository) GetInvoices(customerName string, status models.InvoiceStatus) ([]models.Invoice, error) {
+	var invoices []models.Invoice
+	query := r.db.Preload("Customer").Preload("TimeEntries")
+	if customerName != "" {
+		query = query.Where("customer_id IN (SELECT id FROM customers WHERE name = ?)", customerName)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("series asc, sequence asc").Find(&invoices).Error
+	return invoices, err
+}
+
+// UpdateInvoiceStatus sets the status of an invoice
+func (r *Repository) UpdateInvoiceStatus(id uint, status models.InvoiceStatus) error {
+	return r.db.Model(&models.Invoice{}).Where("id = ?", id).Update("status", status).Error
+}
+
+// VoidInvoice marks an invoice as void and releases its entries in one transaction,
+// so that they can be changed and invoiced again
+func (r *Repository) VoidInvoice(id uint) error {
+	return r.Transaction(func(tx *Repository) error {
+		if err := tx.UpdateInvoiceStatus(id, models.InvoiceVoid); err != nil {
+			return err
+		}
+		return tx.db.Model(&models.TimeEntry{}).Where("invoice_id = ?", id).
+			UpdateColumn("invoice_id", nil).Error
+	})
+}
+
+// Rate card methods
+
+// ResolveRateCard returns the rate card that sets the rate of a consultant's work on a project
+// of a customer on the date, chosen by models.RateCard.Precedence, or nil if no card applies
+func (r *Repository) ResolveRateCard(consultantID, projectID, customerID uint, date time.Time) (*models.RateCard, error) {
+	var cards []models.RateCard
+	err := r.db.Where("valid_from <= ? AND (valid_to IS NULL OR valid_to >= ?)", date, date).
+		Where("(consultant_id IS NULL OR consultant_id = ?)", consultantID).
+		Where("(project_id IS NULL OR project_id = ?)", projectID).
+		Where("(customer_id IS NULL OR customer_id = ?)", customerID).
+		Find(&cards).Error
+	if err != nil || len(cards) == 0 {
+		return nil, err
+	}
+
+	best := cards[0]
+	for _, card := range cards[1:] {
+		if card.Precedence() < best.Precedence() {
+			best = card
+		}
+	}
+	return &best, nil
+}
+
+// rateCardScope restricts a query to the cards with exactly the scope of card
+func rateCardScope(query *gorm.DB, card *models.RateCard) *gorm.DB {
+	columns := []struct {
+		name string
+		id   *uint
+	}{
+		{"consultant_id", card.ConsultantID},
+		{"customer_id", card.CustomerID},
+		{"project_id", card.ProjectID},
+	}
+	for _, column := range columns {
+		if column.id == nil {
+			query = query.Where(column.name + " IS NULL")
+		} else {
+			query = query.Where(column.name+" = ?", *column.id)
+		}
+	}
+	return query
+}
+
+// SetRateCard adds a rate from card.ValidFrom for the scope of card, in one transaction. A
+// card of the scope starting the same day gets the new rate instead. Otherwise the card
+// before ends the day before, and the new card ends the day before the next one starts.
+// card is updated to the stored card.
+func (r *Repository) SetRateCard(card *models.RateCard) error {
+	return r.Transaction(func(tx *Repository) error {
+		var existing []models.RateCard
+		if err := rateCardScope(tx.db, card).Order("valid_from asc").Find(&existing).Error; err != nil {
+			return err
+		}
+
+		card.ValidTo = nil
+		for i := range existing {
+			other := &existing[i]
+			switch {
+			case other.ValidFrom.Equal(card.ValidFrom):
+				other.HourlyRate = card.HourlyRate
+				*card = *other
+				return tx.db.Omit(clause.Associations).Save(card).Error
+			case other.ValidFrom.Before(card.ValidFrom):
+				dayBefore := card.ValidFrom.AddDate(0, 0, -1)
+				if other.ValidTo == nil || other.ValidTo.After(dayBefore) {
+					other.ValidTo = &dayBefore
+					if err := tx.db.Omit(clause.Associations).Save(other).Error; err != nil {
+						return err
+					}
+				}
+			case card.ValidTo == nil:
+				dayBefore := other.ValidFrom.AddDate(0, 0, -1)
+				card.ValidTo = &dayBefore
+			}
+		}
+		return tx.db.Omit(clause.Associations).Create(card).Error
+	})
+}
+
+// GetRateCards returns the rate cards involving the named consultant, customer and project,
+// with their consultant, customer and project loaded. Cards of a project count as cards of
+// its customer. Empty names do not filter; a non-zero date keeps the cards valid on that day.
+// The cards are ordered by consultant, customer and project, then by the day they start.
+func (r *Repository) GetRateCards(consultantName, customerName, projectName string, date time.Time) ([]models.RateCard, error) {
+	var cards []models.RateCard
+	query := r.db.Preload("Consultant").Preload("Customer").Preload("Project.Customer").
+		Joins("LEFT JOIN consultants ON consultants.id = rate_cards.consultant_id").
+		Joins("LEFT JOIN projects ON projects.id = rate_cards.project_id").
+		Joins("LEFT JOIN customers ON customers.id = COALESCE(rate_cards.customer_id, projects.customer_id)")
+
+	if consultantName != "" {
+		query = query.Where("consultants.name = ?", consultantName)
+	}
+	if customerName != "" {
+		query = query.Where("customers.name = ?", customerName)
+	}
+	if projectName != "" {
+		query = query.Where("(projects.name = ? OR projects.code = UPPER(?))", projectName, projectName)
+	}
+	if !date.IsZero() {
+		query = query.Where("rate_cards.valid_from <= ? AND (rate_cards.valid_to IS NULL OR rate_cards.valid_to >= ?)", date, date)
+	}
+
+	err := query.Order("consultants.name NULLS FIRST, customers.name NULLS FIRST, projects.name NULLS FIRST, rate_cards.valid_from asc").
+		Find(&cards).Error
+	return cards, err
+}
+
+// ApplyRateAdjustment changes the hourly rate of the entries of adjustment to its rate and
+// stores the adjustment, in one transaction. Entries with a MergedIntoID are instead added
+// to that entry and deleted, and their import records move along, so that importing them
+// again is still skipped. Nothing is changed if any entry is invoiced or no longer has its
+// old rate.
+func (r *Repository) ApplyRateAdjustment(adjustment *models.RateAdjustment) error {
+	return r.Transaction(func(tx *Repository) error {
+		for _, line := range adjustment.Entries {
+			if line.MergedIntoID != nil {
+				if err := tx.UpdateTimeEntryHours(*line.MergedIntoID, line.Hours); err != nil {
+					return err
+				}
+				err := tx.db.Model(&models.ImportRecord{}).Where("time_entry_id = ?", line.TimeEntryID).
+					UpdateColumn("time_entry_id", *line.MergedIntoID).Error
+				if err != nil {
+					return err
+				}
+				if err := tx.DeleteTimeEntry(line.TimeEntryID); err != nil {
+					return err
+				}
+				continue
+			}
+
+			result := tx.db.Model(&models.TimeEntry{}).
+				Where("id = ? AND invoice_id IS NULL AND hourly_rate = ?", line.TimeEntryID, line.OldRate).
+				UpdateColumn("hourly_rate", adjustment.HourlyRate)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected != 1 {
+				return fmt.Errorf("time entry %d does not exist, is invoiced or has changed", line.TimeEntryID)
+			}
+		}
+		return tx.db.Create(adjustment).Error
+	})
+}
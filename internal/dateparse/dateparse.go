@@ -0,0 +1,144 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+)
+
+var (
+	// -2d, +1w
+	offsetPattern = regexp.MustCompile(`^([+-]\d+)([dw])$`)
+	// 2025-W48-3, 2025-W48
+	isoWeekPattern = regexp.MustCompile(`^(\d{4})-[wW](\d{2})(?:-([1-7]))?$`)
+	// 12-01
+	monthDayPattern = regexp.MustCompile(`^(\d{1,2})-(\d{1,2})$`)
+)
+
+// weekdayKeys maps translation keys to the weekday they name, Monday first
+var weekdayKeys = []struct {
+	key     string
+	weekday time.Weekday
+}{
+	{i18n.KeyDateMonday, time.Monday},
+	{i18n.KeyDateTuesday, time.Tuesday},
+	{i18n.KeyDateWednesday, time.Wednesday},
+	{i18n.KeyDateThursday, time.Thursday},
+	{i18n.KeyDateFriday, time.Friday},
+	{i18n.KeyDateSaturday, time.Saturday},
+	{i18n.KeyDateSunday, time.Sunday},
+}
+
+// Parse interprets a date given on the command line, relative to now. Accepted forms are
+// YYYY-MM-DD, MM-DD in the current year, ISO week dates (2025-W48-3, or 2025-W48 for its
+// Monday), offsets in days or weeks (-2d, +1w), and keywords: today, yesterday, tomorrow,
+// a weekday (its most recent occurrence, today included) and "last" followed by a weekday
+// (that day in the previous week). Keywords are read in the active language and in English.
+// The result is midnight UTC, like every date stored by worklog.
+func Parse(value string, now time.Time) (time.Time, error) {
+	s := strings.ToLower(strings.Join(strings.Fields(value), " "))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+
+	if m := monthDayPattern.FindStringSubmatch(s); m != nil {
+		month, _ := strconv.Atoi(m[1])
+		day, _ := strconv.Atoi(m[2])
+		return civilDate(today.Year(), month, day, value)
+	}
+
+	if m := isoWeekPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		week, _ := strconv.Atoi(m[2])
+		weekday := 1
+		if m[3] != "" {
+			weekday, _ = strconv.Atoi(m[3])
+		}
+		monday := ISOWeekStart(year, week)
+		if week < 1 || monday.AddDate(0, 0, 3).Year() != year {
+			return time.Time{}, invalid(value)
+		}
+		return monday.AddDate(0, 0, weekday-1), nil
+	}
+
+	if m := offsetPattern.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if m[2] == "w" {
+			n *= 7
+		}
+		return today.AddDate(0, 0, n), nil
+	}
+
+	switch {
+	case matchKeyword(i18n.KeyDateToday, s):
+		return today, nil
+	case matchKeyword(i18n.KeyDateYesterday, s):
+		return today.AddDate(0, 0, -1), nil
+	case matchKeyword(i18n.KeyDateTomorrow, s):
+		return today.AddDate(0, 0, 1), nil
+	}
+
+	if weekday, ok := matchWeekday(s); ok {
+		back := (int(today.Weekday()) - int(weekday) + 7) % 7
+		return today.AddDate(0, 0, -back), nil
+	}
+
+	if first, rest, found := strings.Cut(s, " "); found && matchKeyword(i18n.KeyDateLast, first) {
+		if weekday, ok := matchWeekday(rest); ok {
+			// The given weekday of the previous Monday-to-Sunday week
+			monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+			return monday.AddDate(0, 0, -7+(int(weekday)+6)%7), nil
+		}
+	}
+
+	return time.Time{}, invalid(value)
+}
+
+// ISOWeekStart returns the Monday of the given ISO 8601 week, the first week being
+// the one that contains the year's first Thursday
+func ISOWeekStart(year, week int) time.Time {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	return monday.AddDate(0, 0, 7*(week-1))
+}
+
+func civilDate(year, month, day int, value string) (time.Time, error) {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	// Reject dates that time.Date would normalize, such as 02-30
+	if t.Month() != time.Month(month) || t.Day() != day {
+		return time.Time{}, invalid(value)
+	}
+	return t, nil
+}
+
+// matchKeyword reports whether s is one of the comma separated words of a keyword
+// message, in the active language or in English
+func matchKeyword(key, s string) bool {
+	for _, msg := range []string{i18n.T(key), i18n.TranslationIn("en", key)} {
+		for _, word := range strings.Split(msg, ",") {
+			if strings.TrimSpace(word) == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchWeekday(s string) (time.Weekday, bool) {
+	for _, w := range weekdayKeys {
+		if matchKeyword(w.key, s) {
+			return w.weekday, true
+		}
+	}
+	return 0, false
+}
+
+func invalid(value string) error {
+	return fmt.Errorf(i18n.T(i18n.KeyErrInvalidDate), value)
+}
@@ -0,0 +1,77 @@
+package duration
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+)
+
+var (
+	// 1.5, 1,5, .5
+	decimalPattern = regexp.MustCompile(`^(\d*)(?:[.,](\d+))?$`)
+	// 1:30
+	clockPattern = regexp.MustCompile(`^(\d+):(\d{2})$`)
+	// 1h30m, 1h, 90m, 1.5h, 1h 30min
+	unitPattern = regexp.MustCompile(`^(?:(\d+(?:[.,]\d+)?)\s*h)?\s*(?:(\d+)\s*m(?:in)?)?$`)
+)
+
+// ParseHours converts a duration typed on the command line to decimal hours. Accepted forms
+// are decimal hours with a point or comma (1.5, 1,5), hours and minutes (1:30) and units
+// (1h30m, 1h, 90m). A separator followed by exactly three digits, as in 1,500 or 1.500,
+// is rejected since it may be a thousands separator.
+func ParseHours(value string) (float64, error) {
+	s := strings.ToLower(strings.TrimSpace(value))
+	if s == "" {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidDuration), value)
+	}
+
+	if m := decimalPattern.FindStringSubmatch(s); m != nil && (m[1] != "" || m[2] != "") {
+		if len(m[2]) == 3 {
+			return 0, fmt.Errorf(i18n.T(i18n.KeyErrAmbiguousDuration), value)
+		}
+		return parseDecimal(s)
+	}
+
+	if m := clockPattern.FindStringSubmatch(s); m != nil {
+		h, _ := strconv.Atoi(m[1])
+		min, _ := strconv.Atoi(m[2])
+		if min >= 60 {
+			return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidDuration), value)
+		}
+		return float64(h*60+min) / 60, nil
+	}
+
+	if m := unitPattern.FindStringSubmatch(s); m != nil && (m[1] != "" || m[2] != "") {
+		var hours float64
+		if m[1] != "" {
+			h, err := parseDecimal(m[1])
+			if err != nil {
+				return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidDuration), value)
+			}
+			hours = h
+		}
+		if m[2] != "" {
+			min, _ := strconv.Atoi(m[2])
+			// Minutes only (90m) may exceed an hour, but not after an hour part (1h90m, 1.5h30m)
+			if m[1] != "" && (min >= 60 || strings.ContainsAny(m[1], ".,")) {
+				return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidDuration), value)
+			}
+			hours += float64(min) / 60
+		}
+		return hours, nil
+	}
+
+	return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidDuration), value)
+}
+
+func parseDecimal(s string) (float64, error) {
+	return strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+}
+
+// Format returns hours as the shortest decimal that represents them, e.g. 1.5 or 0.25
+func Format(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', -1, 64)
+}
@@ -14,6 +14,9 @@ import (
 //go:embed locales/*.toml
 var localesFS embed.FS
 
+// supportedLanguages lists the languages with an embedded translation file
+var supportedLanguages = []string{"sv", "en"}
+
 var (
 	bundle    *i18n.Bundle
 	localizer *i18n.Localizer
@@ -108,6 +111,12 @@ func normalizeLanguage(lang string) string {
 	return "sv"
 }
 
+// Normalize returns the supported language code for a language name or locale, e.g. the
+// language of a customer; unrecognized languages are Swedish
+func Normalize(lang string) string {
+	return normalizeLanguage(lang)
+}
+
 // T translates a message by its ID with optional template data
 func T(messageID string, templateData ...interface{}) string {
 	if localizer == nil {
@@ -137,6 +146,31 @@ func Tf(messageID string, templateData map[string]interface{}) string {
 	return T(messageID, templateData)
 }
 
+// Translations returns the message in every supported language, e.g. to recognize
+// text written while another language was active
+func Translations(messageID string) []string {
+	var translations []string
+	for _, lang := range supportedLanguages {
+		msg, err := i18n.NewLocalizer(bundle, lang).Localize(&i18n.LocalizeConfig{MessageID: messageID})
+		if err == nil {
+			translations = append(translations, msg)
+		}
+	}
+	return translations
+}
+
+// TranslationIn returns the message in the given language, or "" if it has no translation
+func TranslationIn(lang, messageID string) string {
+	if bundle == nil {
+		return ""
+	}
+	msg, err := i18n.NewLocalizer(bundle, lang).Localize(&i18n.LocalizeConfig{MessageID: messageID})
+	if err != nil {
+		return ""
+	}
+	return msg
+}
+
 // GetCurrentLanguage returns the currently active language code
 func GetCurrentLanguage() string {
 	if currentLang == "" {
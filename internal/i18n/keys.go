@@ -9,22 +9,29 @@ const (
 	KeyRootLong  = "root.long"
 
 	// Add command
-	KeyAddShort           = "add.short"
-	KeyAddLong            = "add.long"
-	KeyAddSuccess         = "add.success"
-	KeyAddSuccessMerged   = "add.success_merged"
-	KeyAddFlagHours       = "add.flag.hours"
-	KeyAddFlagDescription = "add.flag.description"
-	KeyAddFlagProject     = "add.flag.project"
-	KeyAddFlagClient      = "add.flag.client"
-	KeyAddFlagConsultant  = "add.flag.consultant"
-	KeyAddFlagRate        = "add.flag.rate"
-	KeyAddFlagDate        = "add.flag.date"
+	KeyAddShort             = "add.short"
+	KeyAddLong              = "add.long"
+	KeyAddSuccess           = "add.success"
+	KeyAddSuccessMerged     = "add.success_merged"
+	KeyAddFlagHours         = "add.flag.hours"
+	KeyAddFlagDescription   = "add.flag.description"
+	KeyAddFlagProject       = "add.flag.project"
+	KeyAddFlagClient        = "add.flag.client"
+	KeyAddFlagConsultant    = "add.flag.consultant"
+	KeyAddFlagRate          = "add.flag.rate"
+	KeyAddFlagDate          = "add.flag.date"
+	KeyAddFlagStart         = "add.flag.start"
+	KeyAddFlagEnd           = "add.flag.end"
+	KeyAddFlagBreak         = "add.flag.break"
+	KeyAddFlagAllowInactive = "add.flag.allow_inactive"
+	KeyAddWarningOverlap    = "add.warning.overlap"
 
 	// Add output labels
 	KeyAddOutputDate        = "add.output.date"
 	KeyAddOutputConsultant  = "add.output.consultant"
 	KeyAddOutputHours       = "add.output.hours"
+	KeyAddOutputHoursParsed = "add.output.hours_parsed"
+	KeyAddOutputTime        = "add.output.time"
 	KeyAddOutputRate        = "add.output.rate"
 	KeyAddOutputCost        = "add.output.cost"
 	KeyAddOutputProject     = "add.output.project"
@@ -47,6 +54,9 @@ const (
 	KeyGetFlagToday      = "get.flag.today"
 	KeyGetFlagWeek       = "get.flag.week"
 	KeyGetFlagYear       = "get.flag.year"
+	KeyGetFlagQuarter    = "get.flag.quarter"
+	KeyGetFlagFiscalYear = "get.flag.fiscal_year"
+	KeyGetFlagLast       = "get.flag.last"
 	KeyGetFlagOutput     = "get.flag.output"
 	KeyGetFlagOutputFile = "get.flag.output_file"
 
@@ -60,9 +70,435 @@ const (
 	KeyGetHeaderCustomer    = "get.header.customer"
 	KeyGetHeaderDescription = "get.header.description"
 
+	KeyGetHeaderID                 = "get.header.id"
+	KeyGetHeaderProjectDescription = "get.header.project_description"
+	KeyGetHeaderCreatedAt          = "get.header.created_at"
+	KeyGetHeaderUpdatedAt          = "get.header.updated_at"
+	KeyGetHeaderStart              = "get.header.start"
+	KeyGetHeaderEnd                = "get.header.end"
+	KeyGetHeaderWeek               = "get.header.week"
+	KeyGetHeaderMonth              = "get.header.month"
+	KeyGetHeaderVATRate            = "get.header.vat_rate"
+	KeyGetHeaderCurrency           = "get.header.currency"
+	KeyGetFlagCurrency             = "get.flag.currency"
+	KeyGetFlagSummary              = "get.flag.summary"
+	KeyGetFlagGroupBy              = "get.flag.group_by"
+	KeyGetFlagArchived             = "get.flag.archived"
+	KeyGetSummaryTitle             = "get.summary_title"
+	KeyGetFlagShowIDs              = "get.flag.show_ids"
+
 	// Export (used by get command output)
-	KeyExportSuccess = "export.success"
-	KeyExportTotal   = "export.total"
+	KeyExportSuccess  = "export.success"
+	KeyExportTotal    = "export.total"
+	KeyExportSubtotal = "export.subtotal"
+
+	// VAT in reports, exports and invoices
+	KeyVATRate              = "vat.rate"
+	KeyVATTotal             = "vat.total"
+	KeyVATGross             = "vat.gross"
+	KeyVATHeaderGross       = "vat.header_gross"
+	KeyVATRow               = "vat.row"
+	KeyVATGrossRow          = "vat.gross_row"
+	KeyVATDefault           = "vat.default"
+	KeyVATReverseCharge     = "vat.reverse_charge"
+	KeyVATReverseChargeNote = "vat.reverse_charge_note"
+
+	// Customer command
+	KeyCustomerShort = "customer.short"
+	KeyCustomerLong  = "customer.long"
+
+	// Customer management subcommands
+	KeyCustomerListShort             = "customer.list.short"
+	KeyCustomerListLong              = "customer.list.long"
+	KeyCustomerListFlagAll           = "customer.list.flag.all"
+	KeyCustomerListNoResults         = "customer.list.no_results"
+	KeyCustomerAddShort              = "customer.add.short"
+	KeyCustomerAddLong               = "customer.add.long"
+	KeyCustomerAddSuccess            = "customer.add.success"
+	KeyCustomerRenameShort           = "customer.rename.short"
+	KeyCustomerRenameLong            = "customer.rename.long"
+	KeyCustomerRenameSuccess         = "customer.rename.success"
+	KeyCustomerArchiveShort          = "customer.archive.short"
+	KeyCustomerArchiveLong           = "customer.archive.long"
+	KeyCustomerArchiveFlagUndo       = "customer.archive.flag.undo"
+	KeyCustomerArchiveSuccess        = "customer.archive.success"
+	KeyCustomerArchiveRestored       = "customer.archive.restored"
+	KeyCustomerMergeShort            = "customer.merge.short"
+	KeyCustomerMergeLong             = "customer.merge.long"
+	KeyCustomerMergeFlagYes          = "customer.merge.flag.yes"
+	KeyCustomerMergeConfirm          = "customer.merge.confirm"
+	KeyCustomerMergeAborted          = "customer.merge.aborted"
+	KeyCustomerMergeSuccess          = "customer.merge.success"
+	KeyCustomerMergeDroppedRateCards = "customer.merge.dropped_rate_cards"
+	KeyCustomerShowShort             = "customer.show.short"
+	KeyCustomerShowLong              = "customer.show.long"
+
+	// Customer set subcommand
+	KeyCustomerSetShort                  = "customer.set.short"
+	KeyCustomerSetLong                   = "customer.set.long"
+	KeyCustomerSetFlagVATRate            = "customer.set.flag.vat_rate"
+	KeyCustomerSetFlagReverseCharge      = "customer.set.flag.reverse_charge"
+	KeyCustomerSetFlagVATNumber          = "customer.set.flag.vat_number"
+	KeyCustomerSetFlagLanguage           = "customer.set.flag.language"
+	KeyCustomerSetFlagCurrency           = "customer.set.flag.currency"
+	KeyCustomerSetFlagKeepRates          = "customer.set.flag.keep_rates"
+	KeyCustomerSetSuccess                = "customer.set.success"
+	KeyCustomerSetReinterpretedRateCards = "customer.set.reinterpreted_rate_cards"
+
+	// Project command
+	KeyProjectShort            = "project.short"
+	KeyProjectLong             = "project.long"
+	KeyProjectFlagCustomer     = "project.flag.customer"
+	KeyProjectFlagCode         = "project.flag.code"
+	KeyProjectFlagDescription  = "project.flag.description"
+	KeyProjectCustomerArchived = "project.customer_archived"
+
+	// Project management subcommands
+	KeyProjectListShort        = "project.list.short"
+	KeyProjectListLong         = "project.list.long"
+	KeyProjectListFlagCustomer = "project.list.flag.customer"
+	KeyProjectListFlagAll      = "project.list.flag.all"
+	KeyProjectListNoResults    = "project.list.no_results"
+	KeyProjectAddShort         = "project.add.short"
+	KeyProjectAddLong          = "project.add.long"
+	KeyProjectAddFlagCustomer  = "project.add.flag.customer"
+	KeyProjectAddSuccess       = "project.add.success"
+	KeyProjectRenameShort      = "project.rename.short"
+	KeyProjectRenameLong       = "project.rename.long"
+	KeyProjectRenameSuccess    = "project.rename.success"
+	KeyProjectArchiveShort     = "project.archive.short"
+	KeyProjectArchiveLong      = "project.archive.long"
+	KeyProjectArchiveFlagUndo  = "project.archive.flag.undo"
+	KeyProjectArchiveSuccess   = "project.archive.success"
+	KeyProjectArchiveRestored  = "project.archive.restored"
+	KeyProjectMoveShort        = "project.move.short"
+	KeyProjectMoveLong         = "project.move.long"
+	KeyProjectMoveSuccess      = "project.move.success"
+	KeyProjectMoveCurrency     = "project.move.currency"
+	KeyProjectShowShort        = "project.show.short"
+	KeyProjectShowLong         = "project.show.long"
+	KeyProjectSetShort         = "project.set.short"
+	KeyProjectSetLong          = "project.set.long"
+	KeyProjectSetSuccess       = "project.set.success"
+
+	// Consultant command
+	KeyConsultantShort              = "consultant.short"
+	KeyConsultantLong               = "consultant.long"
+	KeyConsultantFlagEmail          = "consultant.flag.email"
+	KeyConsultantFlagEmployeeNumber = "consultant.flag.employee_number"
+	KeyConsultantFlagRate           = "consultant.flag.rate"
+	KeyConsultantFlagCapacity       = "consultant.flag.capacity"
+	KeyConsultantFlagCompany        = "consultant.flag.company"
+	KeyConsultantFlagSubcontractor  = "consultant.flag.subcontractor"
+	KeyConsultantSubcontractor      = "consultant.subcontractor"
+
+	// Consultant management subcommands
+	KeyConsultantListShort       = "consultant.list.short"
+	KeyConsultantListLong        = "consultant.list.long"
+	KeyConsultantListFlagAll     = "consultant.list.flag.all"
+	KeyConsultantListNoResults   = "consultant.list.no_results"
+	KeyConsultantAddShort        = "consultant.add.short"
+	KeyConsultantAddLong         = "consultant.add.long"
+	KeyConsultantAddSuccess      = "consultant.add.success"
+	KeyConsultantRenameShort     = "consultant.rename.short"
+	KeyConsultantRenameLong      = "consultant.rename.long"
+	KeyConsultantRenameSuccess   = "consultant.rename.success"
+	KeyConsultantArchiveShort    = "consultant.archive.short"
+	KeyConsultantArchiveLong     = "consultant.archive.long"
+	KeyConsultantArchiveFlagUndo = "consultant.archive.flag.undo"
+	KeyConsultantArchiveSuccess  = "consultant.archive.success"
+	KeyConsultantArchiveRestored = "consultant.archive.restored"
+	KeyConsultantShowShort       = "consultant.show.short"
+	KeyConsultantShowLong        = "consultant.show.long"
+	KeyConsultantSetShort        = "consultant.set.short"
+	KeyConsultantSetLong         = "consultant.set.long"
+	KeyConsultantSetSuccess      = "consultant.set.success"
+
+	// Rate command
+	KeyRateShort           = "rate.short"
+	KeyRateLong            = "rate.long"
+	KeyRateFlagConsultant  = "rate.flag.consultant"
+	KeyRateFlagCustomer    = "rate.flag.customer"
+	KeyRateFlagProject     = "rate.flag.project"
+	KeyRateHeaderFrom      = "rate.header.from"
+	KeyRateHeaderTo        = "rate.header.to"
+	KeyRateAny             = "rate.any"
+	KeyRateScopeConsultant = "rate.scope.consultant"
+	KeyRateScopeCustomer   = "rate.scope.customer"
+	KeyRateScopeProject    = "rate.scope.project"
+	KeyRateNoResults       = "rate.no_results"
+	KeyRateSetShort        = "rate.set.short"
+	KeyRateSetLong         = "rate.set.long"
+	KeyRateSetFlagFrom     = "rate.set.flag.from"
+	KeyRateSetSuccess      = "rate.set.success"
+	KeyRateSetUntil        = "rate.set.until"
+	KeyRateListShort       = "rate.list.short"
+	KeyRateListLong        = "rate.list.long"
+	KeyRateListFlagDate    = "rate.list.flag.date"
+	KeyRateHistoryShort    = "rate.history.short"
+	KeyRateHistoryLong     = "rate.history.long"
+
+	// Rate apply subcommand
+	KeyRateApplyShort         = "rate.apply.short"
+	KeyRateApplyLong          = "rate.apply.long"
+	KeyRateApplyFlagRate      = "rate.apply.flag.rate"
+	KeyRateApplyFlagMerge     = "rate.apply.flag.merge"
+	KeyRateApplyFlagYes       = "rate.apply.flag.yes"
+	KeyRateApplyFlagDryRun    = "rate.apply.flag.dry_run"
+	KeyRateApplyHeaderNewRate = "rate.apply.header.new_rate"
+	KeyRateApplyHeaderChange  = "rate.apply.header.change"
+	KeyRateApplyNoChanges     = "rate.apply.no_changes"
+	KeyRateApplySkipped       = "rate.apply.skipped"
+	KeyRateApplyMerge         = "rate.apply.merge"
+	KeyRateApplyDelta         = "rate.apply.delta"
+	KeyRateApplyConfirm       = "rate.apply.confirm"
+	KeyRateApplyAborted       = "rate.apply.aborted"
+	KeyRateApplyDryRun        = "rate.apply.dry_run"
+	KeyRateApplySuccess       = "rate.apply.success"
+
+	// Invoice command
+	KeyInvoiceShort = "invoice.short"
+	KeyInvoiceLong  = "invoice.long"
+
+	KeyInvoiceStatusDraft = "invoice.status.draft"
+	KeyInvoiceStatusSent  = "invoice.status.sent"
+	KeyInvoiceStatusPaid  = "invoice.status.paid"
+	KeyInvoiceStatusVoid  = "invoice.status.void"
+
+	KeyInvoiceHeaderNumber    = "invoice.header.number"
+	KeyInvoiceHeaderPeriod    = "invoice.header.period"
+	KeyInvoiceHeaderIssueDate = "invoice.header.issue_date"
+	KeyInvoiceHeaderDueDate   = "invoice.header.due_date"
+	KeyInvoiceHeaderStatus    = "invoice.header.status"
+
+	KeyInvoiceFieldNumber    = "invoice.field.number"
+	KeyInvoiceFieldPeriod    = "invoice.field.period"
+	KeyInvoiceFieldIssueDate = "invoice.field.issue_date"
+	KeyInvoiceFieldDueDate   = "invoice.field.due_date"
+	KeyInvoiceFieldStatus    = "invoice.field.status"
+	KeyInvoiceFieldAmount    = "invoice.field.amount"
+
+	// Invoice create subcommand
+	KeyInvoiceCreateShort         = "invoice.create.short"
+	KeyInvoiceCreateLong          = "invoice.create.long"
+	KeyInvoiceCreateFlagCustomer  = "invoice.create.flag.customer"
+	KeyInvoiceCreateFlagIssueDate = "invoice.create.flag.issue_date"
+	KeyInvoiceCreateFlagDueDays   = "invoice.create.flag.due_days"
+	KeyInvoiceCreateFlagDryRun    = "invoice.create.flag.dry_run"
+	KeyInvoiceCreateSuccess       = "invoice.create.success"
+	KeyInvoiceCreateDryRun        = "invoice.create.dry_run"
+	KeyInvoiceCreateDue           = "invoice.create.due"
+
+	// Invoice list subcommand
+	KeyInvoiceListShort      = "invoice.list.short"
+	KeyInvoiceListLong       = "invoice.list.long"
+	KeyInvoiceListFlagStatus = "invoice.list.flag.status"
+	KeyInvoiceListNoResults  = "invoice.list.no_results"
+
+	// Invoice show subcommand
+	KeyInvoiceShowShort = "invoice.show.short"
+	KeyInvoiceShowLong  = "invoice.show.long"
+
+	// Invoice mark subcommand
+	KeyInvoiceMarkShort   = "invoice.mark.short"
+	KeyInvoiceMarkLong    = "invoice.mark.long"
+	KeyInvoiceMarkSuccess = "invoice.mark.success"
+
+	// Invoice void subcommand
+	KeyInvoiceVoidShort   = "invoice.void.short"
+	KeyInvoiceVoidLong    = "invoice.void.long"
+	KeyInvoiceVoidConfirm = "invoice.void.confirm"
+	KeyInvoiceVoidAborted = "invoice.void.aborted"
+	KeyInvoiceVoidSuccess = "invoice.void.success"
+	KeyInvoiceVoidFlagYes = "invoice.void.flag.yes"
+
+	// Invoice pdf subcommand
+	KeyInvoicePDFShort          = "invoice.pdf.short"
+	KeyInvoicePDFLong           = "invoice.pdf.long"
+	KeyInvoicePDFFlagOutputFile = "invoice.pdf.flag.output_file"
+	KeyInvoicePDFFlagLanguage   = "invoice.pdf.flag.language"
+	KeyInvoicePDFSuccess        = "invoice.pdf.success"
+
+	// Invoice document texts, translated into the language of the customer
+	KeyInvoicePDFTitle       = "invoice.pdf.title"
+	KeyInvoicePDFBillTo      = "invoice.pdf.bill_to"
+	KeyInvoicePDFDescription = "invoice.pdf.description"
+	KeyInvoicePDFHours       = "invoice.pdf.hours"
+	KeyInvoicePDFRate        = "invoice.pdf.rate"
+	KeyInvoicePDFAmount      = "invoice.pdf.amount"
+	KeyInvoicePDFNet         = "invoice.pdf.net"
+	KeyInvoicePDFTotal       = "invoice.pdf.total"
+	KeyInvoicePDFOrgNumber   = "invoice.pdf.org_number"
+	KeyInvoicePDFVATNumber   = "invoice.pdf.vat_number"
+	KeyInvoicePDFEmail       = "invoice.pdf.email"
+	KeyInvoicePDFPhone       = "invoice.pdf.phone"
+	KeyInvoicePDFBankgiro    = "invoice.pdf.bankgiro"
+	KeyInvoicePDFIBAN        = "invoice.pdf.iban"
+	KeyInvoicePDFBIC         = "invoice.pdf.bic"
+	KeyInvoicePDFReference   = "invoice.pdf.reference"
+	KeyInvoicePDFPage        = "invoice.pdf.page"
+
+	// Report command
+	KeyReportShort             = "report.short"
+	KeyReportLong              = "report.long"
+	KeyReportFlagWeek          = "report.flag.week"
+	KeyReportFlagYear          = "report.flag.year"
+	KeyReportFlagTeam          = "report.flag.team"
+	KeyReportFlagExpectedHours = "report.flag.expected_hours"
+	KeyReportFlagOutput        = "report.flag.output"
+	KeyReportFlagCurrency      = "report.flag.currency"
+	KeyReportHeaderAmount      = "report.header.amount"
+	KeyReportSuccess           = "report.success"
+
+	// Report timesheet subcommand
+	KeyReportTimesheetShort          = "report.timesheet.short"
+	KeyReportTimesheetLong           = "report.timesheet.long"
+	KeyReportTimesheetTitle          = "report.timesheet.title"
+	KeyReportTimesheetTeam           = "report.timesheet.team"
+	KeyReportTimesheetDays           = "report.timesheet.days"
+	KeyReportTimesheetLegendTable    = "report.timesheet.legend_table"
+	KeyReportTimesheetLegendMarkdown = "report.timesheet.legend_markdown"
+
+	// Report month subcommand
+	KeyReportMonthShort       = "report.month.short"
+	KeyReportMonthLong        = "report.month.long"
+	KeyReportMonthTitle       = "report.month.title"
+	KeyReportMonthTotalAmount = "report.month.total_amount"
+
+	// Edit command
+	KeyEditShort             = "edit.short"
+	KeyEditLong              = "edit.long"
+	KeyEditSuccess           = "edit.success"
+	KeyEditSuccessMerged     = "edit.success_merged"
+	KeyEditNoChanges         = "edit.no_changes"
+	KeyEditDiffLine          = "edit.diff_line"
+	KeyEditFlagHours         = "edit.flag.hours"
+	KeyEditFlagDescription   = "edit.flag.description"
+	KeyEditFlagProject       = "edit.flag.project"
+	KeyEditFlagClient        = "edit.flag.client"
+	KeyEditFlagConsultant    = "edit.flag.consultant"
+	KeyEditFlagRate          = "edit.flag.rate"
+	KeyEditFlagDate          = "edit.flag.date"
+	KeyEditFlagMerge         = "edit.flag.merge"
+	KeyEditFlagAllowInactive = "edit.flag.allow_inactive"
+
+	// Delete command
+	KeyDeleteShort      = "delete.short"
+	KeyDeleteLong       = "delete.long"
+	KeyDeleteConfirm    = "delete.confirm"
+	KeyDeleteAborted    = "delete.aborted"
+	KeyDeleteDryRun     = "delete.dry_run"
+	KeyDeleteSuccess    = "delete.success"
+	KeyDeleteFlagYes    = "delete.flag.yes"
+	KeyDeleteFlagDryRun = "delete.flag.dry_run"
+
+	// Confirmation prompts: comma separated answers accepted as yes
+	KeyConfirmYes = "confirm.yes"
+
+	// Date keywords: comma separated words accepted by date flags
+	KeyDateToday     = "date.today"
+	KeyDateYesterday = "date.yesterday"
+	KeyDateTomorrow  = "date.tomorrow"
+	KeyDateLast      = "date.last"
+	KeyDateMonday    = "date.monday"
+	KeyDateTuesday   = "date.tuesday"
+	KeyDateWednesday = "date.wednesday"
+	KeyDateThursday  = "date.thursday"
+	KeyDateFriday    = "date.friday"
+	KeyDateSaturday  = "date.saturday"
+	KeyDateSunday    = "date.sunday"
+
+	// Show command
+	KeyShowShort      = "show.short"
+	KeyShowLong       = "show.long"
+	KeyShowFlagOutput = "show.flag.output"
+
+	// Timer commands (start, stop, status, cancel)
+	KeyStartShort           = "start.short"
+	KeyStartLong            = "start.long"
+	KeyStartFlagDescription = "start.flag.description"
+	KeyStopShort            = "stop.short"
+	KeyStopLong             = "stop.long"
+	KeyStopFlagDescription  = "stop.flag.description"
+	KeyStatusShort          = "status.short"
+	KeyStatusLong           = "status.long"
+	KeyCancelShort          = "cancel.short"
+	KeyCancelLong           = "cancel.long"
+	KeyTimerFlagConsultant  = "timer.flag.consultant"
+	KeyTimerStarted         = "timer.started"
+	KeyTimerRunning         = "timer.running"
+	KeyTimerNotRunning      = "timer.not_running"
+	KeyTimerCancelled       = "timer.cancelled"
+	KeyTimerStoppedEmpty    = "timer.stopped_empty"
+
+	// Import command
+	KeyImportShort             = "import.short"
+	KeyImportLong              = "import.long"
+	KeyImportFlagDryRun        = "import.flag.dry_run"
+	KeyImportFlagAllowInactive = "import.flag.allow_inactive"
+	KeyImportCSVShort          = "import.csv.short"
+	KeyImportCSVLong           = "import.csv.long"
+	KeyImportJSONShort         = "import.json.short"
+	KeyImportJSONLong          = "import.json.long"
+	KeyImportRowError          = "import.row_error"
+	KeyImportEntryError        = "import.entry_error"
+	KeyImportDryRun            = "import.dry_run"
+	KeyImportSummary           = "import.summary"
+	KeyImportCreated           = "import.created"
+	KeyImportMerged            = "import.merged"
+	KeyImportSkipped           = "import.skipped"
+	KeyImportReusedIDs         = "import.reused_ids"
+
+	KeyImportTogglShort         = "import.toggl.short"
+	KeyImportTogglLong          = "import.toggl.long"
+	KeyImportClockifyShort      = "import.clockify.short"
+	KeyImportClockifyLong       = "import.clockify.long"
+	KeyImportHarvestShort       = "import.harvest.short"
+	KeyImportHarvestLong        = "import.harvest.long"
+	KeyImportToolFlagConsultant = "import.tool.flag.consultant"
+	KeyImportToolFlagMapping    = "import.tool.flag.mapping"
+	KeyImportToolFlagRate       = "import.tool.flag.rate"
+
+	// Field labels (used in diffs and detail views)
+	KeyFieldID                 = "field.id"
+	KeyFieldDate               = "field.date"
+	KeyFieldConsultant         = "field.consultant"
+	KeyFieldHours              = "field.hours"
+	KeyFieldRate               = "field.rate"
+	KeyFieldCost               = "field.cost"
+	KeyFieldProject            = "field.project"
+	KeyFieldProjectDescription = "field.project_description"
+	KeyFieldCustomer           = "field.customer"
+	KeyFieldDescription        = "field.description"
+	KeyFieldCreatedAt          = "field.created_at"
+	KeyFieldUpdatedAt          = "field.updated_at"
+	KeyFieldStart              = "field.start"
+	KeyFieldEnd                = "field.end"
+	KeyFieldStatus             = "field.status"
+	KeyFieldCurrency           = "field.currency"
+	KeyFieldVAT                = "field.vat"
+	KeyFieldVATNumber          = "field.vat_number"
+	KeyFieldInvoiceLanguage    = "field.invoice_language"
+	KeyFieldProjects           = "field.projects"
+	KeyFieldEntries            = "field.entries"
+	KeyFieldFirstEntry         = "field.first_entry"
+	KeyFieldLastEntry          = "field.last_entry"
+	KeyFieldCode               = "field.code"
+	KeyFieldEmail              = "field.email"
+	KeyFieldEmployeeNumber     = "field.employee_number"
+	KeyFieldCompany            = "field.company"
+	KeyFieldCapacity           = "field.capacity"
+
+	// Customer, project and consultant lists
+	KeyEntityHeaderProjects  = "entity.header.projects"
+	KeyEntityHeaderLastEntry = "entity.header.last_entry"
+	KeyEntityHeaderStatus    = "entity.header.status"
+	KeyEntityActive          = "entity.active"
+	KeyEntityArchived        = "entity.archived"
+	KeyEntityHeaderCode      = "entity.header.code"
+	KeyEntityHeaderCompany   = "entity.header.company"
+	KeyEntityHeaderEmail     = "entity.header.email"
+	KeyEntityHeaderCapacity  = "entity.header.capacity"
 
 	// Config command
 	KeyConfigShort               = "config.short"
@@ -87,6 +523,51 @@ const (
 	KeyConfigFlagDatabaseUser    = "config.flag.database_user"
 	KeyConfigFlagDatabasePass    = "config.flag.database_password"
 	KeyConfigFlagDatabaseName    = "config.flag.database_name"
+	KeyConfigFiscalYearStart     = "config.fiscal_year_start"
+	KeyConfigFlagFiscalYearStart = "config.flag.fiscal_year_start"
+
+	KeyConfigExpectedDailyHours     = "config.expected_daily_hours"
+	KeyConfigFlagExpectedDailyHours = "config.flag.expected_daily_hours"
+
+	// Config invoice section
+	KeyConfigInvoiceTitle       = "config.invoice.title"
+	KeyConfigInvoicePrefix      = "config.invoice.prefix"
+	KeyConfigInvoiceFirst       = "config.invoice.first_number"
+	KeyConfigInvoiceDigits      = "config.invoice.digits"
+	KeyConfigInvoiceDueDays     = "config.invoice.due_days"
+	KeyConfigFlagInvoicePrefix  = "config.flag.invoice_prefix"
+	KeyConfigFlagInvoiceFirst   = "config.flag.invoice_first_number"
+	KeyConfigFlagInvoiceDigits  = "config.flag.invoice_digits"
+	KeyConfigFlagInvoiceDueDays = "config.flag.invoice_due_days"
+	KeyConfigInvoiceVATRate     = "config.invoice.vat_rate"
+	KeyConfigFlagInvoiceVATRate = "config.flag.invoice_vat_rate"
+	KeyConfigInvoiceRounding    = "config.invoice.rounding"
+	KeyConfigRoundingLine       = "config.rounding.line"
+	KeyConfigRoundingTotal      = "config.rounding.total"
+	KeyConfigFlagRounding       = "config.flag.rounding"
+	KeyConfigStrict             = "config.strict"
+	KeyConfigFlagStrict         = "config.flag.strict"
+
+	// Config seller section
+	KeyConfigSellerTitle         = "config.seller.title"
+	KeyConfigSellerName          = "config.seller.name"
+	KeyConfigSellerAddress       = "config.seller.address"
+	KeyConfigSellerOrgNumber     = "config.seller.org_number"
+	KeyConfigSellerVATNumber     = "config.seller.vat_number"
+	KeyConfigSellerEmail         = "config.seller.email"
+	KeyConfigSellerPhone         = "config.seller.phone"
+	KeyConfigSellerBankgiro      = "config.seller.bankgiro"
+	KeyConfigSellerIBAN          = "config.seller.iban"
+	KeyConfigSellerBIC           = "config.seller.bic"
+	KeyConfigFlagSellerName      = "config.flag.seller_name"
+	KeyConfigFlagSellerAddress   = "config.flag.seller_address"
+	KeyConfigFlagSellerOrgNumber = "config.flag.seller_org_number"
+	KeyConfigFlagSellerVATNumber = "config.flag.seller_vat_number"
+	KeyConfigFlagSellerEmail     = "config.flag.seller_email"
+	KeyConfigFlagSellerPhone     = "config.flag.seller_phone"
+	KeyConfigFlagSellerBankgiro  = "config.flag.seller_bankgiro"
+	KeyConfigFlagSellerIBAN      = "config.flag.seller_iban"
+	KeyConfigFlagSellerBIC       = "config.flag.seller_bic"
 
 	// Config set subcommand
 	KeyConfigSetShort = "config.set.short"
@@ -104,13 +585,48 @@ const (
 	KeyConfigDatabaseName  = "config.database.name"
 
 	// Error messages - general
-	KeyErrReadConfig         = "error.read_config"
-	KeyErrLoadConfig         = "error.load_config"
-	KeyErrMustSpecifyValue   = "error.must_specify_value"
-	KeyErrInvalidDateFormat  = "error.invalid_date_format"
+	KeyErrReadConfig          = "error.read_config"
+	KeyErrLoadConfig          = "error.load_config"
+	KeyErrMustSpecifyValue    = "error.must_specify_value"
+	KeyErrInvalidDateFormat   = "error.invalid_date_format"
 	KeyErrHoursMustBePositive = "error.hours_must_be_positive"
-	KeyErrWeekRange          = "error.week_range"
-	KeyErrMonthRange         = "error.month_range"
+	KeyErrWeekRange           = "error.week_range"
+	KeyErrMonthRange          = "error.month_range"
+	KeyErrInvalidQuarter      = "error.invalid_quarter"
+	KeyErrInvalidLast         = "error.invalid_last"
+	KeyErrInvalidGroupBy      = "error.invalid_group_by"
+	KeyErrInvalidArchived     = "error.invalid_archived"
+	KeyErrInvalidDailyHours   = "error.invalid_daily_hours"
+
+	// Error messages - invoices
+	KeyErrInvalidInvoiceSettings = "error.invalid_invoice_settings"
+	KeyErrInvoiceCustomerMissing = "error.invoice_customer_missing"
+	KeyErrCustomerNotFound       = "error.customer_not_found"
+	KeyErrInvoiceNothingToBill   = "error.invoice_nothing_to_bill"
+	KeyErrCreateInvoice          = "error.create_invoice"
+	KeyErrFetchInvoice           = "error.fetch_invoice"
+	KeyErrUpdateInvoice          = "error.update_invoice"
+	KeyErrInvoiceNotFound        = "error.invoice_not_found"
+	KeyErrInvalidInvoiceStatus   = "error.invalid_invoice_status"
+	KeyErrInvoiceIsVoid          = "error.invoice_is_void"
+	KeyErrInvoiceIsPaid          = "error.invoice_is_paid"
+	KeyErrEntryInvoiced          = "error.entry_invoiced"
+	KeyErrInvalidVATRate         = "error.invalid_vat_rate"
+	KeyErrInvalidRounding        = "error.invalid_rounding"
+	KeyErrInvoicePDFVoid         = "error.invoice_pdf_void"
+	KeyErrWritePDF               = "error.write_pdf"
+	KeyErrInvalidLanguage        = "error.invalid_language"
+	KeyErrCustomerNoChanges      = "error.customer_no_changes"
+	KeyErrCustomerCurrencyInUse  = "error.customer_currency_in_use"
+	KeyErrUpdateCustomer         = "error.update_customer"
+	KeyErrInvalidCurrency        = "error.invalid_currency"
+	KeyErrReadExchangeRates      = "error.read_exchange_rates"
+	KeyErrInvalidExchangeRate    = "error.invalid_exchange_rate"
+	KeyErrNoExchangeRate         = "error.no_exchange_rate"
+
+	KeyErrInvalidDate       = "error.invalid_date"
+	KeyErrInvalidDuration   = "error.invalid_duration"
+	KeyErrAmbiguousDuration = "error.ambiguous_duration"
 
 	// Error messages - add command
 	KeyErrConsultantRequired  = "error.consultant_required"
@@ -124,6 +640,90 @@ const (
 	KeyErrUpdateWorkLog       = "error.update_worklog"
 	KeyErrSaveWorkLog         = "error.save_worklog"
 
+	KeyErrInvalidTimeOfDay  = "error.invalid_time_of_day"
+	KeyErrEndBeforeStart    = "error.end_before_start"
+	KeyErrHoursOrTimes      = "error.hours_or_times"
+	KeyErrStartEndTogether  = "error.start_end_together"
+	KeyErrBreakWithoutTimes = "error.break_without_times"
+	KeyErrBreakTooLong      = "error.break_too_long"
+	KeyErrFindOverlapping   = "error.find_overlapping"
+
+	// Error messages - edit command
+	KeyErrInvalidEntryID      = "error.invalid_entry_id"
+	KeyErrEntryNotFound       = "error.entry_not_found"
+	KeyErrFetchWorkLog        = "error.fetch_worklog"
+	KeyErrRateMustBePositive  = "error.rate_must_be_positive"
+	KeyErrEditConflict        = "error.edit_conflict"
+	KeyErrEditEndPastMidnight = "error.edit_end_past_midnight"
+
+	// Error messages - delete command
+	KeyErrDeleteIDsAndFilters   = "error.delete_ids_and_filters"
+	KeyErrDeleteNothingSelected = "error.delete_nothing_selected"
+	KeyErrDeleteWorkLogs        = "error.delete_worklogs"
+
+	// Error messages - timer commands
+	KeyErrFetchTimer               = "error.fetch_timer"
+	KeyErrStartTimer               = "error.start_timer"
+	KeyErrStopTimer                = "error.stop_timer"
+	KeyErrNoRunningTimer           = "error.no_running_timer"
+	KeyErrTimerDescriptionRequired = "error.timer_description_required"
+	KeyErrSwitchTimerDescription   = "error.switch_timer_description"
+
+	// Error messages - import command
+	KeyErrImportOpenFile      = "error.import_open_file"
+	KeyErrImportReadFile      = "error.import_read_file"
+	KeyErrImportUnknownColumn = "error.import_unknown_column"
+	KeyErrImportMissingColumn = "error.import_missing_column"
+	KeyErrImportFieldRequired = "error.import_field_required"
+	KeyErrImportInvalidRows   = "error.import_invalid_rows"
+	KeyErrImportFailed        = "error.import_failed"
+
+	KeyErrImportMapping          = "error.import_mapping"
+	KeyErrImportInvalidTime      = "error.import_invalid_time"
+	KeyErrImportInvalidDuration  = "error.import_invalid_duration"
+	KeyErrInvalidNumber          = "error.invalid_number"
+	KeyErrAmbiguousNumber        = "error.ambiguous_number"
+	KeyErrFetchRateCards         = "error.fetch_rate_cards"
+	KeyErrSaveRateCard           = "error.save_rate_card"
+	KeyErrRateScopeRequired      = "error.rate_scope_required"
+	KeyErrConsultantNotFound     = "error.consultant_not_found"
+	KeyErrProjectNotFound        = "error.project_not_found"
+	KeyErrInvalidRate            = "error.invalid_rate"
+	KeyErrRatePeriodRequired     = "error.rate_period_required"
+	KeyErrRateApplyConflict      = "error.rate_apply_conflict"
+	KeyErrApplyRate              = "error.apply_rate"
+	KeyErrFetchCustomers         = "error.fetch_customers"
+	KeyErrCreateCustomer         = "error.create_customer"
+	KeyErrCustomerNameRequired   = "error.customer_name_required"
+	KeyErrCustomerExists         = "error.customer_exists"
+	KeyErrCustomerSimilar        = "error.customer_similar"
+	KeyErrCustomerStrict         = "error.customer_strict"
+	KeyErrMergeSameCustomer      = "error.merge_same_customer"
+	KeyErrMergeCurrency          = "error.merge_currency"
+	KeyErrMergeCustomers         = "error.merge_customers"
+	KeyErrFetchProjects          = "error.fetch_projects"
+	KeyErrCreateProject          = "error.create_project"
+	KeyErrUpdateProject          = "error.update_project"
+	KeyErrProjectNameRequired    = "error.project_name_required"
+	KeyErrProjectExists          = "error.project_exists"
+	KeyErrProjectUnknown         = "error.project_unknown"
+	KeyErrProjectAmbiguous       = "error.project_ambiguous"
+	KeyErrProjectCodeInvalid     = "error.project_code_invalid"
+	KeyErrProjectCodeTaken       = "error.project_code_taken"
+	KeyErrProjectNoChanges       = "error.project_no_changes"
+	KeyErrProjectSameCustomer    = "error.project_same_customer"
+	KeyErrProjectInvoiced        = "error.project_invoiced"
+	KeyErrFetchConsultants       = "error.fetch_consultants"
+	KeyErrCreateConsultant       = "error.create_consultant"
+	KeyErrUpdateConsultant       = "error.update_consultant"
+	KeyErrConsultantNameRequired = "error.consultant_name_required"
+	KeyErrConsultantExists       = "error.consultant_exists"
+	KeyErrConsultantArchived     = "error.consultant_archived"
+	KeyErrCustomerArchived       = "error.customer_archived"
+	KeyErrProjectArchived        = "error.project_archived"
+	KeyErrConsultantNoChanges    = "error.consultant_no_changes"
+	KeyErrInvalidCapacity        = "error.invalid_capacity"
+
 	// Error messages - get command
 	KeyErrFetchWorkLogs = "error.fetch_worklogs"
 
@@ -0,0 +1,82 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// Record is a time entry read from an import file, identified by consultant,
+// customer and project names rather than database IDs
+type Record struct {
+	ID          uint // of the entry in the database the file was exported from, or 0 if unknown
+	Date        time.Time
+	StartTime   *time.Time // optional, on Date in the same UTC wall clock convention as models.TimeEntry
+	EndTime     *time.Time
+	Consultant  string
+	Customer    string
+	Project     string
+	Description string
+	Hours       float64
+	HourlyRate  models.Money
+}
+
+// RowError describes why a row of an import file was rejected. The position
+// is a line number or an entry number depending on the file format.
+type RowError struct {
+	Position int
+	Err      error
+}
+
+// Validate applies the same rules as `worklog add` to a record
+func (r Record) Validate() error {
+	if r.Date.IsZero() {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrInvalidDateFormat))
+	}
+	if (r.StartTime == nil) != (r.EndTime == nil) {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrStartEndTogether))
+	}
+	if r.StartTime != nil && !r.EndTime.After(*r.StartTime) {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrEndBeforeStart))
+	}
+	if r.Consultant == "" {
+		return fmt.Errorf(i18n.T(i18n.KeyErrImportFieldRequired), i18n.T(i18n.KeyFieldConsultant))
+	}
+	if r.Customer == "" {
+		return fmt.Errorf(i18n.T(i18n.KeyErrImportFieldRequired), i18n.T(i18n.KeyFieldCustomer))
+	}
+	if r.Project == "" {
+		return fmt.Errorf(i18n.T(i18n.KeyErrImportFieldRequired), i18n.T(i18n.KeyFieldProject))
+	}
+	if r.Hours <= 0 {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrHoursMustBePositive))
+	}
+	if r.HourlyRate <= 0 {
+		return fmt.Errorf("%s", i18n.T(i18n.KeyErrRateMustBePositive))
+	}
+	if !r.HourlyRate.IsRate() {
+		return fmt.Errorf(i18n.T(i18n.KeyErrInvalidRate), r.HourlyRate.Exact())
+	}
+	return nil
+}
+
+// Fingerprint joins the fields that identify a record, independent of the file format it came from.
+// Start and end times are only part of it when set, so records without them keep their fingerprint.
+func (r Record) Fingerprint() string {
+	fields := []string{
+		r.Date.Format("2006-01-02"),
+		r.Consultant,
+		r.Customer,
+		r.Project,
+		r.Description,
+		fmt.Sprintf("%.2f", r.Hours),
+		r.HourlyRate.String(),
+	}
+	if r.StartTime != nil && r.EndTime != nil {
+		fields = append(fields, r.StartTime.Format(time.RFC3339), r.EndTime.Format(time.RFC3339))
+	}
+	return strings.Join(fields, "\x1f")
+}
@@ -0,0 +1,427 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// Mapping names the CSV columns a time tracking tool exports each field in. Column names
+// match header cells case-insensitively, and also match headers that add a unit or currency
+// in parentheses, e.g. "Amount" matches "Amount (SEK)".
+type Mapping struct {
+	// User lists the columns naming the consultant, joined with spaces (e.g. first and last name)
+	User        []string `json:"user"`
+	Client      string   `json:"client"`
+	Project     string   `json:"project"`
+	Description string   `json:"description"`
+	StartDate   string   `json:"start_date"`
+	StartTime   string   `json:"start_time"`
+	EndDate     string   `json:"end_date"`
+	EndTime     string   `json:"end_time"`
+	// Duration holds either hh:mm:ss / hh:mm or decimal hours. Without it, hours
+	// are computed from the start and end columns.
+	Duration string `json:"duration"`
+	Rate     string `json:"rate"`
+	// Amount is the billable amount, used to derive the rate when there is no rate column
+	Amount string `json:"amount"`
+	// DateFormat and TimeFormat are Go time layouts, e.g. "01/02/2006" and "03:04:05 PM"
+	DateFormat string `json:"date_format"`
+	TimeFormat string `json:"time_format"`
+	Delimiter  string `json:"delimiter"`
+}
+
+// Column mappings of the detailed CSV exports of supported tools
+var (
+	Toggl = Mapping{
+		User:        []string{"User"},
+		Client:      "Client",
+		Project:     "Project",
+		Description: "Description",
+		StartDate:   "Start date",
+		StartTime:   "Start time",
+		EndDate:     "End date",
+		EndTime:     "End time",
+		Duration:    "Duration",
+		Amount:      "Amount",
+		DateFormat:  "2006-01-02",
+		TimeFormat:  "15:04:05",
+	}
+
+	Clockify = Mapping{
+		User:        []string{"User"},
+		Client:      "Client",
+		Project:     "Project",
+		Description: "Description",
+		StartDate:   "Start Date",
+		StartTime:   "Start Time",
+		EndDate:     "End Date",
+		EndTime:     "End Time",
+		Duration:    "Duration (h)",
+		Rate:        "Billable Rate",
+		Amount:      "Billable Amount",
+		DateFormat:  "01/02/2006",
+		TimeFormat:  "03:04:05 PM",
+	}
+
+	Harvest = Mapping{
+		User:        []string{"First Name", "Last Name"},
+		Client:      "Client",
+		Project:     "Project",
+		Description: "Notes",
+		StartDate:   "Date",
+		Duration:    "Hours",
+		Rate:        "Billable Rate",
+		Amount:      "Billable Amount",
+		DateFormat:  "2006-01-02",
+	}
+)
+
+// Override returns the mapping with every field that is set in other replaced
+func (m Mapping) Override(other Mapping) Mapping {
+	if len(other.User) > 0 {
+		m.User = other.User
+	}
+	for _, field := range []struct{ dst, src *string }{
+		{&m.Client, &other.Client},
+		{&m.Project, &other.Project},
+		{&m.Description, &other.Description},
+		{&m.StartDate, &other.StartDate},
+		{&m.StartTime, &other.StartTime},
+		{&m.EndDate, &other.EndDate},
+		{&m.EndTime, &other.EndTime},
+		{&m.Duration, &other.Duration},
+		{&m.Rate, &other.Rate},
+		{&m.Amount, &other.Amount},
+		{&m.DateFormat, &other.DateFormat},
+		{&m.TimeFormat, &other.TimeFormat},
+		{&m.Delimiter, &other.Delimiter},
+	} {
+		if *field.src != "" {
+			*field.dst = *field.src
+		}
+	}
+	return m
+}
+
+// LoadMapping reads a JSON column mapping file. Unknown keys are rejected to catch typos.
+func LoadMapping(path string) (Mapping, error) {
+	var m Mapping
+
+	f, err := os.Open(path)
+	if err != nil {
+		return m, err
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&m); err != nil {
+		return m, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Options adjusts how an export is read
+type Options struct {
+	// Consultant replaces the user columns for every row when set
+	Consultant string
+	// Rate is used for rows without a rate or amount
+	Rate models.Money
+}
+
+// ParseCSV reads a CSV export described by the mapping. Rows that fail validation
+// are returned as row errors positioned by line number.
+func ParseCSV(r io.Reader, m Mapping, opts Options) ([]Record, []RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	if m.Delimiter != "" {
+		reader.Comma = []rune(m.Delimiter)[0]
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportReadFile), err)
+	}
+	columns := newColumnIndex(header)
+
+	// Every row needs a customer, project, date and either a duration or start and end times
+	required := []string{m.Client, m.Project, m.StartDate}
+	if opts.Consultant == "" {
+		required = append(required, m.User...)
+	}
+	if m.Duration == "" || columns.find(m.Duration) < 0 {
+		required = append(required, m.StartTime, m.EndTime)
+	}
+	for _, name := range required {
+		if name == "" || columns.find(name) < 0 {
+			return nil, nil, fmt.Errorf(i18n.T(i18n.KeyErrImportMissingColumn), name)
+		}
+	}
+
+	var records []Record
+	var rowErrors []RowError
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportReadFile), err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		if isBlankRow(row) {
+			continue
+		}
+
+		record, err := parseToolRow(columns.row(row), m, opts)
+		if err == nil {
+			err = record.Validate()
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Position: line, Err: err})
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, rowErrors, nil
+}
+
+func parseToolRow(field func(name string) string, m Mapping, opts Options) (Record, error) {
+	record := Record{
+		Customer:    field(m.Client),
+		Project:     field(m.Project),
+		Description: field(m.Description),
+		Consultant:  opts.Consultant,
+	}
+
+	if record.Consultant == "" {
+		var names []string
+		for _, column := range m.User {
+			if name := field(column); name != "" {
+				names = append(names, name)
+			}
+		}
+		record.Consultant = strings.Join(names, " ")
+	}
+
+	date, err := parseDate(field(m.StartDate), m.DateFormat)
+	if err != nil {
+		return Record{}, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrInvalidDateFormat), err)
+	}
+	record.Date = date
+
+	// Keep the start and end times when the export has them
+	if field(m.StartTime) != "" && field(m.EndTime) != "" {
+		start, err := parseDateTime(field(m.StartDate), field(m.StartTime), m)
+		if err != nil {
+			return Record{}, err
+		}
+		endDate := field(m.EndDate)
+		if endDate == "" {
+			endDate = field(m.StartDate)
+		}
+		end, err := parseDateTime(endDate, field(m.EndTime), m)
+		if err != nil {
+			return Record{}, err
+		}
+		record.StartTime, record.EndTime = &start, &end
+	}
+
+	// Prefer the exported duration, otherwise measure from start to end
+	if duration := field(m.Duration); duration != "" {
+		record.Hours, err = parseDurationHours(duration)
+		if err != nil {
+			return Record{}, err
+		}
+	} else if record.StartTime != nil {
+		record.Hours = record.EndTime.Sub(*record.StartTime).Hours()
+	}
+
+	// Prefer the exported rate, otherwise derive it from the billable amount
+	if rate := field(m.Rate); rate != "" {
+		record.HourlyRate, err = parseAmount(rate)
+		if err != nil {
+			return Record{}, err
+		}
+	} else if amount := field(m.Amount); amount != "" && record.Hours > 0 {
+		total, err := parseNumber(amount)
+		if err != nil {
+			return Record{}, err
+		}
+		record.HourlyRate = models.NewMoney(math.Round(total/record.Hours*100) / 100)
+	}
+	if record.HourlyRate == 0 {
+		record.HourlyRate = opts.Rate
+	}
+
+	return record, nil
+}
+
+// columnIndex finds mapped columns in a header row
+type columnIndex []string
+
+func newColumnIndex(header []string) columnIndex {
+	columns := make(columnIndex, len(header))
+	for i, cell := range header {
+		columns[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(cell, "\ufeff")))
+	}
+	return columns
+}
+
+// find returns the position of the named column, or -1. A name also matches
+// a header that adds a parenthesized unit, e.g. "Amount" matches "Amount (SEK)".
+func (c columnIndex) find(name string) int {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return -1
+	}
+	for i, cell := range c {
+		if cell == name {
+			return i
+		}
+	}
+	for i, cell := range c {
+		if strings.HasPrefix(cell, name+" (") {
+			return i
+		}
+	}
+	return -1
+}
+
+// row returns a lookup of cell values by column name, empty for unmapped or missing columns
+func (c columnIndex) row(row []string) func(name string) string {
+	return func(name string) string {
+		if i := c.find(name); i >= 0 && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+}
+
+func parseDate(value, layout string) (time.Time, error) {
+	if layout == "" {
+		layout = "2006-01-02"
+	}
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+// parseDateTime combines a date and a time of day. The mapped time layout is tried
+// first, followed by common 24 and 12 hour layouts.
+func parseDateTime(dateValue, timeValue string, m Mapping) (time.Time, error) {
+	date, err := parseDate(dateValue, m.DateFormat)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrInvalidDateFormat), err)
+	}
+
+	layouts := []string{"15:04:05", "15:04", "03:04:05 PM", "03:04 PM", "3:04:05 PM", "3:04 PM"}
+	if m.TimeFormat != "" {
+		layouts = append([]string{m.TimeFormat}, layouts...)
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, timeValue); err == nil {
+			return date.Add(time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf(i18n.T(i18n.KeyErrImportInvalidTime), timeValue)
+}
+
+// parseDurationHours converts hh:mm:ss, hh:mm or decimal hours to hours. Clock
+// durations are converted from whole seconds, so 01:30:00 is exactly 1.5.
+func parseDurationHours(value string) (float64, error) {
+	if !strings.Contains(value, ":") {
+		return parseNumber(value)
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrImportInvalidDuration), value)
+	}
+
+	var seconds int64
+	for i, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || n < 0 || (i > 0 && n >= 60) {
+			return 0, fmt.Errorf(i18n.T(i18n.KeyErrImportInvalidDuration), value)
+		}
+		seconds = seconds*60 + n
+	}
+	if len(parts) == 2 {
+		seconds *= 60
+	}
+
+	return float64(seconds) / 3600, nil
+}
+
+// parseNumber parses numbers written with either a decimal point or a decimal comma,
+// with optional thousands separators, e.g. "1,234.50", "1.234,50", "1 234,50" or "1234.5"
+func parseNumber(value string) (float64, error) {
+	s, ok := normalizeNumber(value)
+	if !ok {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrAmbiguousNumber), value)
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidNumber), value)
+	}
+	return n, nil
+}
+
+// parseAmount parses an amount of money like parseNumber, but exactly
+func parseAmount(value string) (models.Money, error) {
+	s, ok := normalizeNumber(value)
+	if !ok {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrAmbiguousNumber), value)
+	}
+	m, err := models.ParseMoney(s)
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidNumber), value)
+	}
+	return m, nil
+}
+
+// normalizeNumber removes thousands separators and makes the decimal separator a point.
+// With both a comma and a point the one that comes last is the decimal separator; a
+// separator that occurs more than once separates thousands. A single comma or point followed
+// by exactly three digits, as in "1,234" or "1.234", could be either and is reported as not
+// ok, unless nothing but a zero comes before it.
+func normalizeNumber(value string) (string, bool) {
+	s := strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "").Replace(strings.TrimSpace(value))
+
+	comma, point := strings.LastIndex(s, ","), strings.LastIndex(s, ".")
+	switch {
+	case comma >= 0 && point >= 0:
+		if comma > point {
+			return strings.Replace(strings.ReplaceAll(s, ".", ""), ",", ".", 1), true
+		}
+		return strings.ReplaceAll(s, ",", ""), true
+	case strings.Count(s, ",") > 1:
+		return strings.ReplaceAll(s, ",", ""), true
+	case strings.Count(s, ".") > 1:
+		return strings.ReplaceAll(s, ".", ""), true
+	}
+
+	separator := max(comma, point)
+	if separator < 0 {
+		return s, true
+	}
+	if whole := strings.TrimPrefix(s[:separator], "-"); len(s)-separator-1 == 3 && whole != "" && whole != "0" {
+		return s, false
+	}
+	return strings.Replace(s, ",", ".", 1), true
+}
@@ -0,0 +1,98 @@
+package importer
+
+import "testing"
+
+func TestNormalizeNumber(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+		ok    bool
+	}{
+		{"whole number", "1234", "1234", true},
+		{"decimal point", "1234.5", "1234.5", true},
+		{"decimal comma", "1234,5", "1234.5", true},
+		{"point thousands and decimal comma", "1.234,50", "1234.50", true},
+		{"comma thousands and decimal point", "1,234.50", "1234.50", true},
+		{"repeated comma separates thousands", "1,234,567", "1234567", true},
+		{"repeated point separates thousands", "1.234.567", "1234567", true},
+		{"space thousands", "1 234,50", "1234.50", true},
+		{"no-break space thousands", "1\u00a0234,50", "1234.50", true},
+		{"narrow no-break space thousands", "1\u202f234.50", "1234.50", true},
+		{"surrounding spaces", " 12,5 ", "12.5", true},
+		{"two decimals after a comma", "12,50", "12.50", true},
+		{"four decimals after a point", "1.2345", "1.2345", true},
+		{"three decimals after zero and a comma", "0,125", "0.125", true},
+		{"three decimals after zero and a point", "0.125", "0.125", true},
+		{"three decimals without a whole part", ".125", ".125", true},
+		{"negative three decimals after zero", "-0,125", "-0.125", true},
+
+		{"comma and three digits", "1,234", "1,234", false},
+		{"point and three digits", "1.234", "1.234", false},
+		{"negative point and three digits", "-12.500", "-12.500", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := normalizeNumber(tt.value)
+			if ok != tt.ok {
+				t.Fatalf("normalizeNumber(%q) ok = %v, want %v", tt.value, ok, tt.ok)
+			}
+			if ok && got != tt.want {
+				t.Errorf("normalizeNumber(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDurationHours(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  float64
+	}{
+		{"hours, minutes and seconds", "01:30:00", 1.5},
+		{"seconds count exactly", "00:00:36", 0.01},
+		{"more than a day", "25:15:00", 25.25},
+		{"hours and minutes", "2:45", 2.75},
+		{"decimal hours with a point", "1.25", 1.25},
+		{"decimal hours with a comma", "1,5", 1.5},
+		{"three decimals after zero", "0,125", 0.125},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDurationHours(tt.value)
+			if err != nil {
+				t.Fatalf("parseDurationHours(%q) returned error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseDurationHours(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDurationHoursInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"empty", ""},
+		{"text", "abc"},
+		{"too many parts", "1:00:00:00"},
+		{"minutes of 60", "1:60"},
+		{"seconds of 60", "0:00:60"},
+		{"negative minutes", "1:-5"},
+		{"missing minutes", "1:"},
+		{"ambiguous thousands", "1.500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := parseDurationHours(tt.value); err == nil {
+				t.Errorf("parseDurationHours(%q) = %v, want an error", tt.value, got)
+			}
+		})
+	}
+}
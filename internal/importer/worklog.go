@@ -0,0 +1,267 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+)
+
+// worklogCSVColumns maps the header keys written by output.CSVFormatter to the columns they name.
+// Columns that are derived or database specific (cost, currency, VAT rate) are recognized but not
+// imported. The ID only serves to recognize entries exported from the database imported into.
+var worklogCSVColumns = []struct {
+	key      string
+	required bool
+}{
+	{i18n.KeyGetHeaderID, false},
+	{i18n.KeyGetHeaderDate, true},
+	{i18n.KeyGetHeaderStart, false},
+	{i18n.KeyGetHeaderEnd, false},
+	{i18n.KeyGetHeaderConsultant, true},
+	{i18n.KeyGetHeaderProject, true},
+	{i18n.KeyGetHeaderCustomer, true},
+	{i18n.KeyGetHeaderDescription, true},
+	{i18n.KeyGetHeaderHours, true},
+	{i18n.KeyGetHeaderRate, true},
+	{i18n.KeyGetHeaderCost, false},
+	{i18n.KeyGetHeaderCurrency, false},
+	{i18n.KeyGetHeaderVATRate, false},
+}
+
+// ParseWorklogCSV reads a file written by `worklog get -o csv` in any supported language.
+// The totals and subtotal rows are skipped. Rows that fail validation are returned as row errors
+// positioned by line number.
+func ParseWorklogCSV(r io.Reader) ([]Record, []RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportReadFile), err)
+	}
+
+	// Map each header cell to the translation key of the column it names
+	index := make(map[string]int)
+	for i, cell := range header {
+		key := matchWorklogHeader(cell)
+		if key == "" {
+			return nil, nil, fmt.Errorf(i18n.T(i18n.KeyErrImportUnknownColumn), cell)
+		}
+		index[key] = i
+	}
+	for _, column := range worklogCSVColumns {
+		if _, ok := index[column.key]; column.required && !ok {
+			return nil, nil, fmt.Errorf(i18n.T(i18n.KeyErrImportMissingColumn), i18n.T(column.key))
+		}
+	}
+
+	// Totals, subtotal and VAT rows are recognized by their label and by having no rate
+	var totalLabels []string
+	for _, key := range []string{i18n.KeyExportTotal, i18n.KeyExportSubtotal, i18n.KeyVATRow, i18n.KeyVATGrossRow, i18n.KeyVATReverseChargeNote} {
+		totalLabels = append(totalLabels, i18n.Translations(key)...)
+	}
+
+	var records []Record
+	var rowErrors []RowError
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportReadFile), err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		field := func(key string) string {
+			if i, ok := index[key]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		if isBlankRow(row) || (field(i18n.KeyGetHeaderRate) == "" && containsFold(totalLabels, field(i18n.KeyGetHeaderDescription))) {
+			continue
+		}
+
+		record, err := parseWorklogCSVRow(field)
+		if err == nil {
+			err = record.Validate()
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Position: line, Err: err})
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, rowErrors, nil
+}
+
+func parseWorklogCSVRow(field func(key string) string) (Record, error) {
+	var id uint64
+	if value := field(i18n.KeyGetHeaderID); value != "" {
+		var err error
+		id, err = strconv.ParseUint(value, 10, 0)
+		if err != nil {
+			return Record{}, fmt.Errorf(i18n.T(i18n.KeyErrInvalidNumber), value)
+		}
+	}
+	date, err := time.Parse("2006-01-02", field(i18n.KeyGetHeaderDate))
+	if err != nil {
+		return Record{}, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrInvalidDateFormat), err)
+	}
+	hours, err := strconv.ParseFloat(field(i18n.KeyGetHeaderHours), 64)
+	if err != nil {
+		return Record{}, fmt.Errorf(i18n.T(i18n.KeyErrInvalidNumber), field(i18n.KeyGetHeaderHours))
+	}
+	rate, err := models.ParseMoney(field(i18n.KeyGetHeaderRate))
+	if err != nil {
+		return Record{}, fmt.Errorf(i18n.T(i18n.KeyErrInvalidNumber), field(i18n.KeyGetHeaderRate))
+	}
+	start, end, err := parseClockRange(date, field(i18n.KeyGetHeaderStart), field(i18n.KeyGetHeaderEnd))
+	if err != nil {
+		return Record{}, err
+	}
+
+	return Record{
+		ID:          uint(id),
+		Date:        date,
+		StartTime:   start,
+		EndTime:     end,
+		Consultant:  field(i18n.KeyGetHeaderConsultant),
+		Customer:    field(i18n.KeyGetHeaderCustomer),
+		Project:     field(i18n.KeyGetHeaderProject),
+		Description: field(i18n.KeyGetHeaderDescription),
+		Hours:       hours,
+		HourlyRate:  rate,
+	}, nil
+}
+
+// matchWorklogHeader returns the translation key of the column named by a header cell, or ""
+func matchWorklogHeader(cell string) string {
+	cell = strings.TrimSpace(strings.TrimPrefix(cell, "\ufeff"))
+	for _, column := range worklogCSVColumns {
+		if containsFold(i18n.Translations(column.key), cell) {
+			return column.key
+		}
+	}
+	return ""
+}
+
+// ParseWorklogJSON reads either a document written by `worklog get -o json` or a stream
+// of entry objects (NDJSON); both may be mixed in one input. Entries that fail validation
+// are returned as row errors positioned by entry number, counting from 1.
+func ParseWorklogJSON(r io.Reader) ([]Record, []RowError, error) {
+	decoder := json.NewDecoder(r)
+
+	var entries []output.JSONEntry
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportReadFile), err)
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportReadFile), err)
+		}
+
+		if _, ok := fields["entries"]; ok {
+			var document output.JSONOutput
+			if err := json.Unmarshal(raw, &document); err != nil {
+				return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportReadFile), err)
+			}
+			entries = append(entries, document.Entries...)
+			continue
+		}
+
+		var entry output.JSONEntry
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrImportReadFile), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	var records []Record
+	var rowErrors []RowError
+	for i, entry := range entries {
+		date, err := time.Parse("2006-01-02", entry.Date)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Position: i + 1, Err: fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrInvalidDateFormat), err)})
+			continue
+		}
+
+		start, end, err := parseClockRange(date, entry.StartTime, entry.EndTime)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Position: i + 1, Err: err})
+			continue
+		}
+
+		record := Record{
+			ID:          entry.ID,
+			Date:        date,
+			StartTime:   start,
+			EndTime:     end,
+			Consultant:  entry.Consultant,
+			Customer:    entry.Customer,
+			Project:     entry.Project,
+			Description: entry.Description,
+			Hours:       entry.Hours,
+			HourlyRate:  entry.HourlyRate,
+		}
+		if err := record.Validate(); err != nil {
+			rowErrors = append(rowErrors, RowError{Position: i + 1, Err: err})
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, rowErrors, nil
+}
+
+// parseClockRange places optional HH:MM start and end times on date. Both are nil when empty.
+func parseClockRange(date time.Time, startValue, endValue string) (*time.Time, *time.Time, error) {
+	var times []*time.Time
+	for _, value := range []string{startValue, endValue} {
+		if value == "" {
+			times = append(times, nil)
+			continue
+		}
+		t, err := time.Parse("15:04", value)
+		if err != nil {
+			return nil, nil, fmt.Errorf(i18n.T(i18n.KeyErrInvalidTimeOfDay), value)
+		}
+		clock := time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC)
+		times = append(times, &clock)
+	}
+	return times[0], times[1], nil
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBlankRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
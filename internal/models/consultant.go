@@ -4,10 +4,31 @@ import "time"
 
 // Consultant represents a consultant/developer that can log time
 type Consultant struct {
-	ID          uint        `gorm:"primaryKey"`
-	Name        string      `gorm:"uniqueIndex;not null"`
-	Active      bool        `gorm:"default:true"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	TimeEntries []TimeEntry `gorm:"foreignKey:ConsultantID"`
+	ID             uint    `gorm:"primaryKey"`
+	Name           string  `gorm:"uniqueIndex;not null"`
+	Active         bool    `gorm:"default:true"`
+	Email          string  // contact address, shown on the consultant
+	EmployeeNumber string  // number in the payroll or HR system of the employer
+	DefaultRate    Money   `gorm:"type:numeric(10,2);not null;default:0"` // used when no rate card applies; 0 means the configured default rate
+	WeeklyCapacity float64 `gorm:"type:numeric(5,2);not null;default:0"`  // hours per week, checked by the timesheet; 0 means the configured expected hours
+	Company        string  // employer, or the own company of a subcontractor
+	Subcontractor  bool    `gorm:"not null;default:false"` // works through their own company, which is named on invoices
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	TimeEntries    []TimeEntry `gorm:"foreignKey:ConsultantID"`
+}
+
+// DailyCapacity returns the hours the consultant is expected to work per weekday, from the
+// weekly capacity spread over Monday to Friday, or 0 if the capacity is not set
+func (c Consultant) DailyCapacity() float64 {
+	return c.WeeklyCapacity / 5
+}
+
+// InvoiceName returns how the consultant is named on invoice lines: a subcontractor
+// together with their company, e.g. "Anna Andersson (Andersson IT AB)"
+func (c Consultant) InvoiceName() string {
+	if c.Subcontractor && c.Company != "" {
+		return c.Name + " (" + c.Company + ")"
+	}
+	return c.Name
 }
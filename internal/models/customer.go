@@ -2,12 +2,47 @@ package models
 
 import "time"
 
+// DefaultCurrency is the currency of customers that have none set
+const DefaultCurrency = "SEK"
+
 // Customer represents a client/customer
 type Customer struct {
-	ID        uint      `gorm:"primaryKey"`
-	Name      string    `gorm:"uniqueIndex;not null"`
-	Active    bool      `gorm:"default:true"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Projects  []Project `gorm:"foreignKey:CustomerID"`
+	ID            uint     `gorm:"primaryKey"`
+	Name          string   `gorm:"uniqueIndex;not null"`
+	Active        bool     `gorm:"default:true"`
+	Language      string   `gorm:"type:varchar(5)"` // language of invoices, e.g. "sv"; empty means the configured language
+	VATNumber     string   // VAT registration number of the customer, required on reverse charge invoices
+	VATRate       *float64 `gorm:"type:numeric(5,2)"`                    // percent; nil means the configured default
+	ReverseCharge bool     `gorm:"not null;default:false"`               // invoiced without VAT, which the customer accounts for
+	Currency      string   `gorm:"type:varchar(3);not null;default:SEK"` // ISO 4217 code the hourly rates and invoices are in
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Projects      []Project `gorm:"foreignKey:CustomerID"`
+}
+
+// EffectiveVATRate returns the VAT rate in percent to charge the customer, given the
+// configured default rate
+func (c Customer) EffectiveVATRate(defaultRate float64) float64 {
+	switch {
+	case c.ReverseCharge:
+		return 0
+	case c.VATRate != nil:
+		return *c.VATRate
+	default:
+		return defaultRate
+	}
+}
+
+// CurrencyCode returns the currency the customer is billed in
+func (c Customer) CurrencyCode() string {
+	if c.Currency == "" {
+		return DefaultCurrency
+	}
+	return c.Currency
+}
+
+// VAT returns the VAT on a net amount at a rate in percent, rounded to öre. It is applied
+// to the total per customer, not to each time entry.
+func VAT(net Money, rate float64) Money {
+	return net.Percent(rate)
 }
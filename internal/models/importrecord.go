@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// ImportRecord remembers a row that has been imported, so that importing
+// the same data again skips it instead of adding its hours a second time
+type ImportRecord struct {
+	ID          uint      `gorm:"primaryKey"`
+	Hash        string    `gorm:"uniqueIndex;not null"`
+	Source      string    `gorm:"not null"`
+	TimeEntryID uint      `gorm:"not null;index"`
+	TimeEntry   TimeEntry `gorm:"foreignKey:TimeEntryID;constraint:OnDelete:CASCADE"`
+	CreatedAt   time.Time
+}
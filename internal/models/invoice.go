@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// InvoiceStatus is the state of an invoice
+type InvoiceStatus string
+
+const (
+	InvoiceDraft InvoiceStatus = "draft"
+	InvoiceSent  InvoiceStatus = "sent"
+	InvoicePaid  InvoiceStatus = "paid"
+	InvoiceVoid  InvoiceStatus = "void"
+)
+
+// Invoice bills the time entries of a customer for a period. The number is Series followed
+// by Sequence, which counts up within the series. Entries on an invoice are locked; voiding
+// the invoice releases them but keeps the number.
+type Invoice struct {
+	ID            uint          `gorm:"primaryKey"`
+	Number        string        `gorm:"uniqueIndex;not null"`
+	Series        string        `gorm:"not null;uniqueIndex:idx_invoice_series_sequence"`
+	Sequence      int           `gorm:"not null;uniqueIndex:idx_invoice_series_sequence"`
+	CustomerID    uint          `gorm:"not null;index"`
+	Customer      Customer      `gorm:"foreignKey:CustomerID"`
+	PeriodStart   time.Time     `gorm:"not null"`
+	PeriodEnd     time.Time     `gorm:"not null"` // last day of the period, inclusive
+	IssueDate     time.Time     `gorm:"not null"`
+	DueDate       time.Time     `gorm:"not null"`
+	Status        InvoiceStatus `gorm:"type:varchar(10);not null;default:draft"`
+	VATRate       float64       `gorm:"type:numeric(5,2);not null;default:25"`  // percent, fixed when the invoice is created
+	ReverseCharge bool          `gorm:"not null;default:false"`                 // copied from the customer when the invoice is created
+	Currency      string        `gorm:"type:varchar(3);not null;default:SEK"`   // copied from the customer when the invoice is created
+	Rounding      Rounding      `gorm:"type:varchar(5);not null;default:total"` // the configured rounding rule when the invoice is created
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	TimeEntries   []TimeEntry `gorm:"foreignKey:InvoiceID"`
+}
+
+// Totals returns the hours and amount of the entries on the invoice, the amount rounded to
+// öre by the rounding rule of the invoice
+func (i Invoice) Totals() (hours float64, amount Money) {
+	for _, entry := range i.TimeEntries {
+		hours += entry.Hours
+		amount += i.Rounding.LineCost(entry.Hours, entry.HourlyRate)
+	}
+	return hours, amount.Round()
+}
+
+// Amounts returns the amount of the invoice before VAT, the VAT and the amount to pay, all
+// rounded to öre
+func (i Invoice) Amounts() (net, vat, gross Money) {
+	_, net = i.Totals()
+	vat = VAT(net, i.VATRate)
+	return net, vat, net + vat
+}
@@ -0,0 +1,192 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact amount of money counted in ten-thousandths of the currency unit. The
+// cost of a time entry, hours with two decimals times a rate with two decimals, is exact;
+// amounts are rounded to öre (cents) as the rounding rule says and when they are shown.
+type Money int64
+
+// moneyScale is the number of Money units in one krona (euro, ...)
+const moneyScale = 10000
+
+// centUnits is the number of Money units in one öre (cent)
+const centUnits = moneyScale / 100
+
+// NewMoney converts a float, such as a rate typed on the command line, to Money. Values
+// with up to four decimals convert exactly.
+func NewMoney(value float64) Money {
+	return Money(math.Round(value * moneyScale))
+}
+
+// ParseMoney parses a decimal number such as "1250", "1250.5" or "-0.25" exactly. A comma
+// is accepted as decimal separator. More than four decimals are rounded.
+func ParseMoney(value string) (Money, error) {
+	s := strings.ReplaceAll(strings.TrimSpace(value), ",", ".")
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	units := int64(0)
+	if whole != "" {
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || n > math.MaxInt64/moneyScale {
+			return 0, fmt.Errorf("invalid amount %q", value)
+		}
+		units = n * moneyScale
+	}
+
+	for _, digit := range fraction {
+		if digit < '0' || digit > '9' {
+			return 0, fmt.Errorf("invalid amount %q", value)
+		}
+	}
+	// Four decimals are kept; the fifth decides the rounding of the fourth
+	digits := fraction + "00000"
+	for i, digit := range digits[:4] {
+		units += int64(digit-'0') * int64(math.Pow10(3-i))
+	}
+	if digits[4] >= '5' {
+		units++
+	}
+
+	if negative {
+		units = -units
+	}
+	return Money(units), nil
+}
+
+// Cost returns the exact cost of hours at an hourly rate. Hours are taken with two
+// decimals, the precision they are entered and rounded with.
+func Cost(hours float64, rate Money) Money {
+	return divRound(Money(math.Round(hours*100))*rate, 100)
+}
+
+// Round rounds to öre (cents), halves away from zero
+func (m Money) Round() Money {
+	return divRound(m, centUnits) * centUnits
+}
+
+// IsRate reports whether the amount can be an hourly rate: more than zero and in whole
+// öre, since rates are stored with two decimals
+func (m Money) IsRate() bool {
+	return m > 0 && m.Round() == m
+}
+
+// Percent returns the given percentage of the amount rounded to öre, as VAT is. The rate
+// is taken with two decimals.
+func (m Money) Percent(rate float64) Money {
+	hundredths := Money(math.Round(rate * 100))
+	return divRound(m.Round()*hundredths, 100*100*centUnits) * centUnits
+}
+
+// divRound divides and rounds halves away from zero
+func divRound(n, d Money) Money {
+	if n < 0 {
+		return -divRound(-n, d)
+	}
+	return (n + d/2) / d
+}
+
+// Float64 returns the amount as a float, for arithmetic that is approximate anyway such as
+// currency conversion
+func (m Money) Float64() float64 {
+	return float64(m) / moneyScale
+}
+
+// String formats the amount rounded to öre with two decimals, e.g. "1250.50"
+func (m Money) String() string {
+	return m.format(2)
+}
+
+// Exact formats the amount without rounding, with two to four decimals, e.g. "2500.825"
+func (m Money) Exact() string {
+	s := m.format(4)
+	for strings.HasSuffix(s, "0") && len(s)-strings.IndexByte(s, '.') > 3 {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// format writes the amount with the given number of decimals (2 or 4), rounding as needed
+func (m Money) format(decimals int) string {
+	if decimals == 2 {
+		m = m.Round()
+	}
+	sign := ""
+	if m < 0 {
+		sign = "-"
+		m = -m
+	}
+	whole, fraction := int64(m)/moneyScale, int64(m)%moneyScale
+	return fmt.Sprintf("%s%d.%0*d", sign, whole, decimals, fraction/int64(math.Pow10(4-decimals)))
+}
+
+// MarshalJSON writes the exact amount as a JSON number
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.Exact()), nil
+}
+
+// UnmarshalJSON reads a JSON number exactly
+func (m *Money) UnmarshalJSON(data []byte) error {
+	value, err := ParseMoney(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*m = value
+	return nil
+}
+
+// Scan reads a numeric column, which the driver returns as text, or a sum of one
+func (m *Money) Scan(src any) error {
+	var err error
+	switch value := src.(type) {
+	case nil:
+		*m = 0
+	case string:
+		*m, err = ParseMoney(value)
+	case []byte:
+		*m, err = ParseMoney(string(value))
+	case float64:
+		*m = NewMoney(value)
+	case int64:
+		*m = Money(value * moneyScale)
+	default:
+		err = fmt.Errorf("cannot scan %T into Money", src)
+	}
+	return err
+}
+
+// Value writes the exact amount to a numeric column
+func (m Money) Value() (driver.Value, error) {
+	return m.Exact(), nil
+}
+
+// Rounding is the rule for when costs are rounded to öre
+type Rounding string
+
+const (
+	// RoundPerLine rounds the cost of every time entry before it is added up, as an
+	// accounting system that books each line does
+	RoundPerLine Rounding = "line"
+	// RoundPerTotal adds up the exact costs and rounds only the totals
+	RoundPerTotal Rounding = "total"
+)
+
+// LineCost returns the cost of hours at an hourly rate, rounded to öre under RoundPerLine
+func (r Rounding) LineCost(hours float64, rate Money) Money {
+	cost := Cost(hours, rate)
+	if r == RoundPerLine {
+		return cost.Round()
+	}
+	return cost
+}
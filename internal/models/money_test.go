@@ -0,0 +1,118 @@
+package models
+
+import "testing"
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  Money
+	}{
+		{"whole amount", "1250", 12500000},
+		{"one decimal", "1250.5", 12505000},
+		{"two decimals", "1250.55", 12505500},
+		{"four decimals are kept", "2500.8255", 25008255},
+		{"fifth decimal rounds up", "0.00005", 1},
+		{"fifth decimal rounds down", "0.00004", 0},
+		{"decimal comma", "950,25", 9502500},
+		{"surrounding spaces", " 100 ", 1000000},
+		{"only decimals", ".5", 5000},
+		{"negative amount", "-0.25", -2500},
+		{"explicit plus sign", "+12", 120000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.value)
+			if err != nil {
+				t.Fatalf("ParseMoney(%q) returned error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMoney(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMoneyInvalid(t *testing.T) {
+	for _, value := range []string{"", " ", "-", ".", "abc", "12.3a", "1.2.3", "99999999999999999"} {
+		t.Run(value, func(t *testing.T) {
+			if got, err := ParseMoney(value); err == nil {
+				t.Errorf("ParseMoney(%q) = %d, want an error", value, got)
+			}
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		name  string
+		value Money
+		want  Money
+	}{
+		{"whole öre stay", 12505500, 12505500},
+		{"below half rounds down", 12505549, 12505500},
+		{"half rounds up", 12505550, 12505600},
+		{"negative half rounds away from zero", -12505550, -12505600},
+		{"negative below half rounds towards zero", -12505549, -12505500},
+		{"zero", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.Round(); got != tt.want {
+				t.Errorf("Money(%d).Round() = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCost(t *testing.T) {
+	tests := []struct {
+		name  string
+		hours float64
+		rate  Money
+		want  Money
+	}{
+		{"whole hours", 8, 12500000, 100000000},
+		{"hours and rate with two decimals are exact", 1.25, 9502500, 11878125},
+		{"hours are taken with two decimals", 1.256, 10000000, 12600000},
+		{"float error in hours is dropped", 0.1 + 0.2, 10000000, 3000000},
+		{"no hours", 0, 12500000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Cost(tt.hours, tt.rate); got != tt.want {
+				t.Errorf("Cost(%v, %s) = %s, want %s", tt.hours, tt.rate.Exact(), got.Exact(), tt.want.Exact())
+			}
+		})
+	}
+}
+
+func TestIsRate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"1250", true},
+		{"950.25", true},
+		{"0.01", true},
+		{"950.255", false},
+		{"0.0001", false},
+		{"0", false},
+		{"-100", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			rate, err := ParseMoney(tt.value)
+			if err != nil {
+				t.Fatalf("ParseMoney(%q) returned error: %v", tt.value, err)
+			}
+			if got := rate.IsRate(); got != tt.want {
+				t.Errorf("IsRate() of %q = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
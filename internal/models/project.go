@@ -1,16 +1,38 @@
 package models
 
-import "time"
+import (
+	"regexp"
+	"strings"
+	"time"
+)
 
 // Project represents a project belonging to a customer
 type Project struct {
-	ID          uint        `gorm:"primaryKey"`
-	Name        string      `gorm:"not null;index"`
-	CustomerID  uint        `gorm:"not null;index"`
-	Customer    Customer    `gorm:"foreignKey:CustomerID"`
-	Active      bool        `gorm:"default:true"`
-	Description string      `gorm:"type:text"`
+	ID          uint     `gorm:"primaryKey"`
+	Name        string   `gorm:"not null;index"`
+	Code        *string  `gorm:"type:varchar(20);uniqueIndex"` // short unique code such as "HSB-FRK", usable instead of the name
+	CustomerID  uint     `gorm:"not null;index"`
+	Customer    Customer `gorm:"foreignKey:CustomerID"`
+	Active      bool     `gorm:"default:true"`
+	Description string   `gorm:"type:text"`
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	TimeEntries []TimeEntry `gorm:"foreignKey:ProjectID"`
 }
+
+// CodeString returns the code of the project, or "" if it has none
+func (p Project) CodeString() string {
+	if p.Code == nil {
+		return ""
+	}
+	return *p.Code
+}
+
+var projectCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{0,19}$`)
+
+// ParseProjectCode normalizes a project code to upper case, e.g. "hsb-frk" to "HSB-FRK".
+// A code is at most 20 letters, digits, dashes and underscores.
+func ParseProjectCode(value string) (string, bool) {
+	code := strings.ToUpper(strings.TrimSpace(value))
+	return code, projectCodePattern.MatchString(code)
+}
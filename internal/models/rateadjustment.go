@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// RateAdjustment records a change of the hourly rate of existing time entries, made when a
+// rate is renegotiated retroactively. It keeps the scope and period that were changed, and
+// the previous rate of every entry, so the change can be traced afterwards.
+type RateAdjustment struct {
+	ID           uint                  `gorm:"primaryKey"`
+	ConsultantID *uint                 `gorm:"index"`
+	CustomerID   *uint                 `gorm:"index"`
+	ProjectID    *uint                 `gorm:"index"`
+	StartDate    *time.Time            // first day of the period, nil when open
+	EndDate      *time.Time            // last day of the period, inclusive, nil when open
+	HourlyRate   Money                 `gorm:"type:numeric(10,2);not null"`
+	Entries      []RateAdjustmentEntry `gorm:"foreignKey:AdjustmentID;constraint:OnDelete:CASCADE"`
+	CreatedAt    time.Time
+}
+
+// RateAdjustmentEntry is a time entry changed by a RateAdjustment. An entry that became
+// identical to another at the new rate is merged into it and deleted; MergedIntoID then
+// names the entry that took over its hours. TimeEntryID is therefore not a foreign key.
+type RateAdjustmentEntry struct {
+	ID           uint    `gorm:"primaryKey"`
+	AdjustmentID uint    `gorm:"not null;index"`
+	TimeEntryID  uint    `gorm:"not null;index"`
+	Hours        float64 `gorm:"not null"`
+	OldRate      Money   `gorm:"type:numeric(10,2);not null"`
+	MergedIntoID *uint
+}
@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+// RateCard is an hourly rate agreed for a consultant, a customer or a project, or for a
+// consultant on a customer or project. Project cards leave CustomerID empty; the project
+// belongs to one customer. A card applies from ValidFrom through ValidTo, or until further
+// notice when ValidTo is nil. The cards of one scope never overlap: a new rate ends the
+// previous one the day before it starts.
+type RateCard struct {
+	ID           uint        `gorm:"primaryKey"`
+	ConsultantID *uint       `gorm:"index"`
+	Consultant   *Consultant `gorm:"foreignKey:ConsultantID"`
+	CustomerID   *uint       `gorm:"index"`
+	Customer     *Customer   `gorm:"foreignKey:CustomerID"`
+	ProjectID    *uint       `gorm:"index"`
+	Project      *Project    `gorm:"foreignKey:ProjectID"`
+	HourlyRate   Money       `gorm:"type:numeric(10,2);not null"`
+	ValidFrom    time.Time   `gorm:"not null;index"`
+	ValidTo      *time.Time  // last day the rate applies, inclusive
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Precedence returns the rank of the card when several apply to an entry; the lowest wins.
+// A consultant on a project comes first, then a consultant on a customer, a project, a
+// customer, and last a consultant alone.
+func (c RateCard) Precedence() int {
+	switch {
+	case c.ConsultantID != nil && c.ProjectID != nil:
+		return 0
+	case c.ConsultantID != nil && c.CustomerID != nil:
+		return 1
+	case c.ProjectID != nil:
+		return 2
+	case c.CustomerID != nil:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// AppliesOn reports whether the card is valid on the date
+func (c RateCard) AppliesOn(date time.Time) bool {
+	return !date.Before(c.ValidFrom) && (c.ValidTo == nil || !date.After(*c.ValidTo))
+}
+
+// BilledCustomer returns the customer of the card, directly or through its project, or nil
+// for a card of a consultant alone. Customer and Project.Customer must be loaded.
+func (c RateCard) BilledCustomer() *Customer {
+	if c.Customer != nil {
+		return c.Customer
+	}
+	if c.Project != nil {
+		return &c.Project.Customer
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// HoursSummary is the sum of the time entries sharing a customer, project, consultant and
+// hourly rate. It is computed by the database and not stored.
+type HoursSummary struct {
+	Customer   string
+	Project    string
+	Consultant string
+	HourlyRate Money
+	Entries    int
+	Hours      float64
+	Amount     Money // rounded as the rounding rule says
+
+	// Currency and VAT settings of the customer
+	Currency      string
+	VATRate       *float64
+	ReverseCharge bool
+}
+
+// Activity is the number and hours of the time entries of a customer, project or consultant,
+// and the days of the first and last of them. It is computed by the database and not stored.
+type Activity struct {
+	Entries   int
+	Hours     float64
+	FirstDate *time.Time
+	LastDate  *time.Time
+}
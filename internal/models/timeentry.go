@@ -9,12 +9,25 @@ type TimeEntry struct {
 	ID           uint       `gorm:"primaryKey"`
 	Date         time.Time  `gorm:"not null;index"`
 	Hours        float64    `gorm:"not null"`
+	StartTime    *time.Time // optional, on Date in the same UTC wall clock convention
+	EndTime      *time.Time // optional, set together with StartTime
 	Description  string     `gorm:"type:text;not null"`
-	HourlyRate   float64    `gorm:"type:numeric(10,2);not null"`
+	HourlyRate   Money      `gorm:"type:numeric(10,2);not null"`
 	ProjectID    uint       `gorm:"not null;index"`
 	Project      Project    `gorm:"foreignKey:ProjectID"`
 	ConsultantID uint       `gorm:"not null;index"`
 	Consultant   Consultant `gorm:"foreignKey:ConsultantID"`
+	InvoiceID    *uint      `gorm:"index"` // set while the entry is on an invoice, which locks it
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
+
+// HasTimes reports whether the entry records when the work started and ended
+func (e TimeEntry) HasTimes() bool {
+	return e.StartTime != nil && e.EndTime != nil
+}
+
+// IsInvoiced reports whether the entry is on an invoice and may no longer be changed
+func (e TimeEntry) IsInvoiced() bool {
+	return e.InvoiceID != nil
+}
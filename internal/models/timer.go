@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Timer is a running time measurement for a consultant. When stopped, the
+// elapsed time becomes a TimeEntry. A consultant has at most one running timer.
+type Timer struct {
+	ID           uint       `gorm:"primaryKey"`
+	ConsultantID uint       `gorm:"not null;uniqueIndex"`
+	Consultant   Consultant `gorm:"foreignKey:ConsultantID"`
+	ProjectID    uint       `gorm:"not null;index"`
+	Project      Project    `gorm:"foreignKey:ProjectID"`
+	Description  string     `gorm:"type:text"`
+	HourlyRate   Money      `gorm:"type:numeric(10,2);not null"`
+	StartedAt    time.Time  `gorm:"not null"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,127 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// consultantCompany describes who a consultant works for, e.g. "Limer Konsult AB" or
+// "Andersson IT AB (subcontractor)"
+func consultantCompany(consultant models.Consultant) string {
+	if consultant.Subcontractor {
+		return strings.TrimSpace(consultant.Company + " (" + i18n.T(i18n.KeyConsultantSubcontractor) + ")")
+	}
+	return consultant.Company
+}
+
+// consultantRate formats the default rate of a consultant, or "" if none is set
+func consultantRate(consultant models.Consultant) string {
+	if consultant.DefaultRate == 0 {
+		return ""
+	}
+	return consultant.DefaultRate.String()
+}
+
+// consultantCapacity formats the weekly capacity of a consultant, or "" if none is set
+func consultantCapacity(consultant models.Consultant) string {
+	if consultant.WeeklyCapacity == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.2f", consultant.WeeklyCapacity)
+}
+
+// FormatConsultants writes consultants as a table with their company, email, default rate,
+// weekly capacity and the hours logged to date
+func FormatConsultants(consultants []models.Consultant, activity map[uint]models.Activity, writer io.Writer) error {
+	header := []string{
+		i18n.T(i18n.KeyGetHeaderConsultant),
+		i18n.T(i18n.KeyEntityHeaderCompany),
+		i18n.T(i18n.KeyEntityHeaderEmail),
+		i18n.T(i18n.KeyGetHeaderRate),
+		i18n.T(i18n.KeyEntityHeaderCapacity),
+		i18n.T(i18n.KeyEntityHeaderStatus),
+		i18n.T(i18n.KeyGetHeaderHours),
+		i18n.T(i18n.KeyEntityHeaderLastEntry),
+	}
+	// The rate, capacity and hours columns hold numbers and are right aligned
+	numeric := map[int]bool{3: true, 4: true, 6: true}
+
+	rows := [][]string{header}
+	for _, consultant := range consultants {
+		rows = append(rows, []string{
+			consultant.Name,
+			consultantCompany(consultant),
+			consultant.Email,
+			consultantRate(consultant),
+			consultantCapacity(consultant),
+			ActiveLabel(consultant.Active),
+			fmt.Sprintf("%.2f", activity[consultant.ID].Hours),
+			lastEntry(activity[consultant.ID]),
+		})
+	}
+
+	widths := make([]int, len(header))
+	for _, row := range rows {
+		for col, cell := range row {
+			widths[col] = max(widths[col], utf8.RuneCountInString(cell))
+		}
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for col, cell := range row {
+			if numeric[col] {
+				cells[col] = strings.Repeat(" ", widths[col]-utf8.RuneCountInString(cell)) + cell
+			} else {
+				cells[col] = padRight(cell, widths[col])
+			}
+		}
+		fmt.Fprintln(writer, strings.TrimRight(strings.Join(cells, "   "), " "))
+	}
+
+	return nil
+}
+
+// FormatConsultant writes the profile of a consultant and the work logged to date
+func FormatConsultant(consultant models.Consultant, activity models.Activity, writer io.Writer) error {
+	rate := consultantRate(consultant)
+	if rate == "" {
+		rate = i18n.T(i18n.KeyVATDefault)
+	}
+
+	fields := []struct {
+		label string
+		value string
+	}{
+		{i18n.T(i18n.KeyFieldConsultant), consultant.Name},
+		{i18n.T(i18n.KeyFieldStatus), ActiveLabel(consultant.Active)},
+		{i18n.T(i18n.KeyFieldEmail), consultant.Email},
+		{i18n.T(i18n.KeyFieldEmployeeNumber), consultant.EmployeeNumber},
+		{i18n.T(i18n.KeyFieldCompany), consultantCompany(consultant)},
+		{i18n.T(i18n.KeyFieldRate), rate},
+		{i18n.T(i18n.KeyFieldCapacity), consultantCapacity(consultant)},
+		{i18n.T(i18n.KeyFieldEntries), fmt.Sprintf("%d", activity.Entries)},
+		{i18n.T(i18n.KeyFieldHours), fmt.Sprintf("%.2f", activity.Hours)},
+		{i18n.T(i18n.KeyFieldFirstEntry), firstEntry(activity)},
+		{i18n.T(i18n.KeyFieldLastEntry), lastEntry(activity)},
+		{i18n.T(i18n.KeyFieldCreatedAt), consultant.CreatedAt.In(time.Local).Format(timestampLayout)},
+	}
+
+	labelWidth := 0
+	for _, field := range fields {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(field.label))
+	}
+
+	for _, field := range fields {
+		padding := labelWidth - utf8.RuneCountInString(field.label)
+		fmt.Fprintf(writer, "%s:%*s %s\n", field.label, padding, "", field.value)
+	}
+
+	return nil
+}
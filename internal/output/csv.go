@@ -4,13 +4,29 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"strconv"
+	"time"
 
 	"github.com/LimerDev/worklog/internal/i18n"
 	"github.com/LimerDev/worklog/internal/models"
 )
 
 // CSVFormatter formats entries as CSV
-type CSVFormatter struct{}
+type CSVFormatter struct {
+	opts Options
+}
+
+// Positions of the columns written by Format that subtotal rows fill in
+const (
+	csvColumnDate        = 1
+	csvColumnConsultant  = 4
+	csvColumnProject     = 5
+	csvColumnCustomer    = 6
+	csvColumnDescription = 7
+	csvColumnHours       = 8
+	csvColumnCost        = 10
+	csvColumnCurrency    = 11
+)
 
 // Format writes entries to the writer in CSV format
 func (f *CSVFormatter) Format(entries []models.TimeEntry, writer io.Writer) error {
@@ -19,7 +35,10 @@ func (f *CSVFormatter) Format(entries []models.TimeEntry, writer io.Writer) erro
 
 	// Write header
 	header := []string{
+		i18n.T(i18n.KeyGetHeaderID),
 		i18n.T(i18n.KeyGetHeaderDate),
+		i18n.T(i18n.KeyGetHeaderStart),
+		i18n.T(i18n.KeyGetHeaderEnd),
 		i18n.T(i18n.KeyGetHeaderConsultant),
 		i18n.T(i18n.KeyGetHeaderProject),
 		i18n.T(i18n.KeyGetHeaderCustomer),
@@ -27,47 +46,163 @@ func (f *CSVFormatter) Format(entries []models.TimeEntry, writer io.Writer) erro
 		i18n.T(i18n.KeyGetHeaderHours),
 		i18n.T(i18n.KeyGetHeaderRate),
 		i18n.T(i18n.KeyGetHeaderCost),
+		i18n.T(i18n.KeyGetHeaderCurrency),
+	}
+	if f.opts.DefaultVATRate != nil {
+		header = append(header, i18n.T(i18n.KeyGetHeaderVATRate))
 	}
 	if err := csvWriter.Write(header); err != nil {
 		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteCSVHeader), err)
 	}
 
-	var totalHours float64
-	var totalCost float64
-
 	// Write data rows
 	for _, entry := range entries {
-		cost := entry.Hours * entry.HourlyRate
+		// The exact cost, so that the rows add up to the totals under either rounding rule
+		cost := f.opts.lineCost(entry)
 		row := []string{
+			strconv.FormatUint(uint64(entry.ID), 10),
 			entry.Date.Format("2006-01-02"),
+			FormatClock(entry.StartTime),
+			FormatClock(entry.EndTime),
 			entry.Consultant.Name,
 			entry.Project.Name,
 			entry.Project.Customer.Name,
 			entry.Description,
 			fmt.Sprintf("%.2f", entry.Hours),
-			fmt.Sprintf("%.2f", entry.HourlyRate),
-			fmt.Sprintf("%.2f", cost),
+			entry.HourlyRate.String(),
+			cost.Exact(),
+			entryCurrency(entry),
+		}
+		if f.opts.DefaultVATRate != nil {
+			row = append(row, strconv.FormatFloat(entryVATRate(entry, *f.opts.DefaultVATRate), 'f', -1, 64))
 		}
 		if err := csvWriter.Write(row); err != nil {
 			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteCSVRow), err)
 		}
-		totalHours += entry.Hours
-		totalCost += cost
 	}
 
-	// Write totals row
-	totalsRow := []string{
-		"",
-		"",
-		"",
-		"",
-		i18n.T(i18n.KeyExportTotal),
-		fmt.Sprintf("%.2f", totalHours),
-		"",
-		fmt.Sprintf("%.2f", totalCost),
-	}
-	if err := csvWriter.Write(totalsRow); err != nil {
-		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteTotalsRow), err)
+	t, err := f.opts.sumEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	// Write a totals row per currency, the first with the total hours, followed by the VAT
+	// and the total including VAT, and the reverse charge note for each customer it
+	// applies to
+	var totalsRows [][]string
+	moneyRow := func(label string, m money) []string {
+		row := make([]string, len(header))
+		row[csvColumnDescription] = label
+		row[csvColumnCost] = m.amount.String()
+		row[csvColumnCurrency] = m.currency
+		return row
+	}
+	for i, cost := range t.cost {
+		row := moneyRow(i18n.T(i18n.KeyExportTotal), cost)
+		if i == 0 {
+			row[csvColumnHours] = fmt.Sprintf("%.2f", t.hours)
+		}
+		totalsRows = append(totalsRows, row)
+	}
+	for _, vat := range t.vat {
+		totalsRows = append(totalsRows, moneyRow(i18n.T(i18n.KeyVATRow), vat))
+	}
+	for _, gross := range t.gross {
+		totalsRows = append(totalsRows, moneyRow(i18n.T(i18n.KeyVATGrossRow), gross))
+	}
+	for _, customer := range t.reverseCharge {
+		row := make([]string, len(header))
+		row[csvColumnCustomer] = customer
+		row[csvColumnDescription] = i18n.T(i18n.KeyVATReverseChargeNote)
+		totalsRows = append(totalsRows, row)
+	}
+	for _, row := range totalsRows {
+		if err := csvWriter.Write(row); err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteTotalsRow), err)
+		}
+	}
+
+	// Write subtotal rows, each grouping after a blank row. The key goes in the column
+	// it was taken from, periods in the date column.
+	for _, by := range f.opts.GroupBy {
+		if err := csvWriter.Write(make([]string, len(header))); err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteTotalsRow), err)
+		}
+		subtotals, err := f.opts.groupEntries(entries, by)
+		if err != nil {
+			return err
+		}
+		for _, sub := range subtotals {
+			row := make([]string, len(header))
+			switch by {
+			case GroupByConsultant:
+				row[csvColumnConsultant] = sub.first.Consultant.Name
+			case GroupByProject:
+				row[csvColumnProject] = sub.first.Project.Name
+				row[csvColumnCustomer] = sub.first.Project.Customer.Name
+			case GroupByCustomer:
+				row[csvColumnCustomer] = sub.first.Project.Customer.Name
+			default:
+				row[csvColumnDate] = sub.key
+			}
+			row[csvColumnDescription] = i18n.T(i18n.KeyExportSubtotal)
+			row[csvColumnHours] = fmt.Sprintf("%.2f", sub.hours)
+			row[csvColumnCost] = sub.cost.amount.String()
+			row[csvColumnCurrency] = sub.cost.currency
+			if err := csvWriter.Write(row); err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteTotalsRow), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// FormatEntry writes a header and a single row with every field of the entry
+func (f *CSVFormatter) FormatEntry(entry models.TimeEntry, writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	defer csvWriter.Flush()
+
+	header := []string{
+		i18n.T(i18n.KeyGetHeaderID),
+		i18n.T(i18n.KeyGetHeaderDate),
+		i18n.T(i18n.KeyGetHeaderStart),
+		i18n.T(i18n.KeyGetHeaderEnd),
+		i18n.T(i18n.KeyGetHeaderConsultant),
+		i18n.T(i18n.KeyGetHeaderProject),
+		i18n.T(i18n.KeyGetHeaderProjectDescription),
+		i18n.T(i18n.KeyGetHeaderCustomer),
+		i18n.T(i18n.KeyGetHeaderDescription),
+		i18n.T(i18n.KeyGetHeaderHours),
+		i18n.T(i18n.KeyGetHeaderRate),
+		i18n.T(i18n.KeyGetHeaderCost),
+		i18n.T(i18n.KeyGetHeaderCurrency),
+		i18n.T(i18n.KeyGetHeaderCreatedAt),
+		i18n.T(i18n.KeyGetHeaderUpdatedAt),
+	}
+	if err := csvWriter.Write(header); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteCSVHeader), err)
+	}
+
+	row := []string{
+		strconv.FormatUint(uint64(entry.ID), 10),
+		entry.Date.Format("2006-01-02"),
+		FormatClock(entry.StartTime),
+		FormatClock(entry.EndTime),
+		entry.Consultant.Name,
+		entry.Project.Name,
+		entry.Project.Description,
+		entry.Project.Customer.Name,
+		entry.Description,
+		fmt.Sprintf("%.2f", entry.Hours),
+		entry.HourlyRate.String(),
+		f.opts.lineCost(entry).Exact(),
+		entryCurrency(entry),
+		entry.CreatedAt.In(time.Local).Format(timestampLayout),
+		entry.UpdatedAt.In(time.Local).Format(timestampLayout),
+	}
+	if err := csvWriter.Write(row); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteCSVRow), err)
 	}
 
 	return nil
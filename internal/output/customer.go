@@ -0,0 +1,144 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// ActiveLabel returns the translated status of a customer, project or consultant
+func ActiveLabel(active bool) string {
+	if active {
+		return i18n.T(i18n.KeyEntityActive)
+	}
+	return i18n.T(i18n.KeyEntityArchived)
+}
+
+// customerVAT describes the VAT charged to a customer, e.g. "VAT 25%" or "reverse charge"
+func customerVAT(customer models.Customer, defaultVATRate float64) string {
+	if customer.ReverseCharge {
+		return i18n.T(i18n.KeyVATReverseCharge)
+	}
+	return VATLabel(customer.EffectiveVATRate(defaultVATRate))
+}
+
+// firstEntry formats the day of the first time entry of an activity, or "" if there is none
+func firstEntry(activity models.Activity) string {
+	if activity.FirstDate == nil {
+		return ""
+	}
+	return activity.FirstDate.Format("2006-01-02")
+}
+
+// lastEntry formats the day of the last time entry of an activity, or "" if there is none
+func lastEntry(activity models.Activity) string {
+	if activity.LastDate == nil {
+		return ""
+	}
+	return activity.LastDate.Format("2006-01-02")
+}
+
+// FormatCustomers writes customers as a table with their currency, VAT, number of projects
+// and the hours logged to date. The projects of each customer must be loaded.
+func FormatCustomers(customers []models.Customer, activity map[uint]models.Activity, defaultVATRate float64, writer io.Writer) error {
+	header := []string{
+		i18n.T(i18n.KeyGetHeaderCustomer),
+		i18n.T(i18n.KeyGetHeaderCurrency),
+		i18n.T(i18n.KeyVATRow),
+		i18n.T(i18n.KeyEntityHeaderStatus),
+		i18n.T(i18n.KeyEntityHeaderProjects),
+		i18n.T(i18n.KeyGetHeaderHours),
+		i18n.T(i18n.KeyEntityHeaderLastEntry),
+	}
+	// The projects and hours columns hold numbers and are right aligned
+	numeric := map[int]bool{4: true, 5: true}
+
+	rows := [][]string{header}
+	for _, customer := range customers {
+		rows = append(rows, []string{
+			customer.Name,
+			customer.CurrencyCode(),
+			customerVAT(customer, defaultVATRate),
+			ActiveLabel(customer.Active),
+			fmt.Sprintf("%d", len(customer.Projects)),
+			fmt.Sprintf("%.2f", activity[customer.ID].Hours),
+			lastEntry(activity[customer.ID]),
+		})
+	}
+
+	widths := make([]int, len(header))
+	for _, row := range rows {
+		for col, cell := range row {
+			widths[col] = max(widths[col], utf8.RuneCountInString(cell))
+		}
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for col, cell := range row {
+			if numeric[col] {
+				cells[col] = strings.Repeat(" ", widths[col]-utf8.RuneCountInString(cell)) + cell
+			} else {
+				cells[col] = padRight(cell, widths[col])
+			}
+		}
+		fmt.Fprintln(writer, strings.TrimRight(strings.Join(cells, "   "), " "))
+	}
+
+	return nil
+}
+
+// FormatCustomer writes the settings of a customer, its projects and the work logged to
+// date. The projects of the customer must be loaded.
+func FormatCustomer(customer models.Customer, activity models.Activity, defaultVATRate float64, writer io.Writer) error {
+	projects := make([]string, len(customer.Projects))
+	for i, project := range customer.Projects {
+		projects[i] = project.Name
+		if !project.Active {
+			projects[i] += " (" + ActiveLabel(false) + ")"
+		}
+	}
+	vat := customerVAT(customer, defaultVATRate)
+	if customer.VATRate == nil && !customer.ReverseCharge {
+		vat += " (" + i18n.T(i18n.KeyVATDefault) + ")"
+	}
+	language := customer.Language
+	if language == "" {
+		language = i18n.T(i18n.KeyVATDefault)
+	}
+
+	fields := []struct {
+		label string
+		value string
+	}{
+		{i18n.T(i18n.KeyFieldCustomer), customer.Name},
+		{i18n.T(i18n.KeyFieldStatus), ActiveLabel(customer.Active)},
+		{i18n.T(i18n.KeyFieldCurrency), customer.CurrencyCode()},
+		{i18n.T(i18n.KeyFieldVAT), vat},
+		{i18n.T(i18n.KeyFieldVATNumber), customer.VATNumber},
+		{i18n.T(i18n.KeyFieldInvoiceLanguage), language},
+		{i18n.T(i18n.KeyFieldProjects), strings.Join(projects, ", ")},
+		{i18n.T(i18n.KeyFieldEntries), fmt.Sprintf("%d", activity.Entries)},
+		{i18n.T(i18n.KeyFieldHours), fmt.Sprintf("%.2f", activity.Hours)},
+		{i18n.T(i18n.KeyFieldFirstEntry), firstEntry(activity)},
+		{i18n.T(i18n.KeyFieldLastEntry), lastEntry(activity)},
+		{i18n.T(i18n.KeyFieldCreatedAt), customer.CreatedAt.In(time.Local).Format(timestampLayout)},
+	}
+
+	labelWidth := 0
+	for _, field := range fields {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(field.label))
+	}
+
+	for _, field := range fields {
+		padding := labelWidth - utf8.RuneCountInString(field.label)
+		fmt.Fprintf(writer, "%s:%*s %s\n", field.label, padding, "", field.value)
+	}
+
+	return nil
+}
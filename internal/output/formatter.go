@@ -2,7 +2,9 @@ package output
 
 import (
 	"io"
+	"time"
 
+	"github.com/LimerDev/worklog/internal/currency"
 	"github.com/LimerDev/worklog/internal/models"
 )
 
@@ -13,23 +15,59 @@ const (
 	FormatTable Format = "table"
 	FormatCSV   Format = "csv"
 	FormatJSON  Format = "json"
+	// FormatMarkdown is supported by reports; entry lists fall back to a table
+	FormatMarkdown Format = "markdown"
 )
 
+// Options controls optional parts of the output
+type Options struct {
+	// ShowIDs adds an ID column to table output. CSV and JSON always include IDs.
+	ShowIDs bool
+	// GroupBy adds subtotals per grouping: table sections, CSV subtotal rows and a JSON summary object
+	GroupBy []GroupBy
+	// DefaultVATRate is charged to customers without a VAT rate of their own. When set, the
+	// totals include VAT; nil leaves VAT out.
+	DefaultVATRate *float64
+	// Converter converts totals and subtotals into one currency. Without it amounts in
+	// different currencies are totalled separately.
+	Converter *currency.Converter
+	// Rounding decides whether the cost of each entry is rounded to öre before it is added
+	// up; the zero value rounds only totals
+	Rounding models.Rounding
+}
+
 // Formatter is the interface for all output formatters
 type Formatter interface {
+	// Format writes a list of entries with totals
 	Format(entries []models.TimeEntry, writer io.Writer) error
+	// FormatEntry writes every field of a single entry
+	FormatEntry(entry models.TimeEntry, writer io.Writer) error
 }
 
 // GetFormatter returns the appropriate formatter for the given format
-func GetFormatter(format Format) Formatter {
+func GetFormatter(format Format, opts Options) Formatter {
 	switch format {
 	case FormatCSV:
-		return &CSVFormatter{}
+		return &CSVFormatter{opts: opts}
 	case FormatJSON:
-		return &JSONFormatter{}
+		return &JSONFormatter{opts: opts}
 	case FormatTable:
 		fallthrough
 	default:
-		return &TableFormatter{}
+		return &TableFormatter{opts: opts}
+	}
+}
+
+// timestampLayout is used for CreatedAt/UpdatedAt in table and CSV output
+const timestampLayout = "2006-01-02 15:04:05"
+
+// clockLayout is used for the start and end times of entries
+const clockLayout = "15:04"
+
+// FormatClock returns the time of day of t, or "" if it is not set
+func FormatClock(t *time.Time) string {
+	if t == nil {
+		return ""
 	}
+	return t.Format(clockLayout)
 }
@@ -0,0 +1,126 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// InvoiceStatusLabel returns the translated name of an invoice status
+func InvoiceStatusLabel(status models.InvoiceStatus) string {
+	switch status {
+	case models.InvoiceDraft:
+		return i18n.T(i18n.KeyInvoiceStatusDraft)
+	case models.InvoiceSent:
+		return i18n.T(i18n.KeyInvoiceStatusSent)
+	case models.InvoicePaid:
+		return i18n.T(i18n.KeyInvoiceStatusPaid)
+	case models.InvoiceVoid:
+		return i18n.T(i18n.KeyInvoiceStatusVoid)
+	default:
+		return string(status)
+	}
+}
+
+// invoicePeriod formats the period of an invoice, e.g. "2025-11-01 – 2025-11-30"
+func invoicePeriod(invoice models.Invoice) string {
+	return invoice.PeriodStart.Format("2006-01-02") + " – " + invoice.PeriodEnd.Format("2006-01-02")
+}
+
+// FormatInvoices writes invoices as a table with their hours and amount. The entries of
+// each invoice must be loaded.
+func FormatInvoices(invoices []models.Invoice, writer io.Writer) error {
+	header := []string{
+		i18n.T(i18n.KeyInvoiceHeaderNumber),
+		i18n.T(i18n.KeyGetHeaderCustomer),
+		i18n.T(i18n.KeyInvoiceHeaderPeriod),
+		i18n.T(i18n.KeyInvoiceHeaderIssueDate),
+		i18n.T(i18n.KeyInvoiceHeaderDueDate),
+		i18n.T(i18n.KeyInvoiceHeaderStatus),
+		i18n.T(i18n.KeyGetHeaderHours),
+		i18n.T(i18n.KeyReportHeaderAmount),
+		i18n.T(i18n.KeyVATHeaderGross),
+	}
+	// The last three columns hold numbers and are right aligned
+	const textColumns = 6
+
+	rows := [][]string{header}
+	for _, invoice := range invoices {
+		hours, amount := invoice.Totals()
+		_, _, gross := invoice.Amounts()
+		rows = append(rows, []string{
+			invoice.Number,
+			invoice.Customer.Name,
+			invoicePeriod(invoice),
+			invoice.IssueDate.Format("2006-01-02"),
+			invoice.DueDate.Format("2006-01-02"),
+			InvoiceStatusLabel(invoice.Status),
+			fmt.Sprintf("%.2f", hours),
+			currency.Format(amount, invoice.Currency),
+			currency.Format(gross, invoice.Currency),
+		})
+	}
+
+	widths := make([]int, len(header))
+	for _, row := range rows {
+		for col, cell := range row {
+			widths[col] = max(widths[col], utf8.RuneCountInString(cell))
+		}
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for col, cell := range row {
+			if col < textColumns {
+				cells[col] = padRight(cell, widths[col])
+			} else {
+				cells[col] = strings.Repeat(" ", widths[col]-utf8.RuneCountInString(cell)) + cell
+			}
+		}
+		fmt.Fprintln(writer, strings.Join(cells, "   "))
+	}
+
+	return nil
+}
+
+// FormatInvoice writes the details of an invoice as a list of labelled lines, followed by
+// the reverse charge note when it applies
+func FormatInvoice(invoice models.Invoice, writer io.Writer) error {
+	hours, _ := invoice.Totals()
+	net, vat, gross := invoice.Amounts()
+	fields := []struct {
+		label string
+		value string
+	}{
+		{i18n.T(i18n.KeyInvoiceFieldNumber), invoice.Number},
+		{i18n.T(i18n.KeyFieldCustomer), invoice.Customer.Name},
+		{i18n.T(i18n.KeyInvoiceFieldPeriod), invoicePeriod(invoice)},
+		{i18n.T(i18n.KeyInvoiceFieldIssueDate), invoice.IssueDate.Format("2006-01-02")},
+		{i18n.T(i18n.KeyInvoiceFieldDueDate), invoice.DueDate.Format("2006-01-02")},
+		{i18n.T(i18n.KeyInvoiceFieldStatus), InvoiceStatusLabel(invoice.Status)},
+		{i18n.T(i18n.KeyFieldHours), fmt.Sprintf("%.2f", hours)},
+		{i18n.T(i18n.KeyInvoiceFieldAmount), currency.Format(net, invoice.Currency)},
+		{VATLabel(invoice.VATRate), currency.Format(vat, invoice.Currency)},
+		{i18n.T(i18n.KeyVATGross), currency.Format(gross, invoice.Currency)},
+	}
+
+	labelWidth := 0
+	for _, field := range fields {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(field.label))
+	}
+
+	for _, field := range fields {
+		padding := labelWidth - utf8.RuneCountInString(field.label)
+		fmt.Fprintf(writer, "%s:%*s %s\n", field.label, padding, "", field.value)
+	}
+	if invoice.ReverseCharge {
+		fmt.Fprintf(writer, "\n%s\n", i18n.T(i18n.KeyVATReverseChargeNote))
+	}
+
+	return nil
+}
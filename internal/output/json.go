@@ -3,64 +3,177 @@ package output
 import (
 	"encoding/json"
 	"io"
+	"time"
 
+	"github.com/LimerDev/worklog/internal/i18n"
 	"github.com/LimerDev/worklog/internal/models"
 )
 
 // JSONFormatter formats entries as JSON
-type JSONFormatter struct{}
+type JSONFormatter struct {
+	opts Options
+}
 
 // JSONEntry represents a time entry in JSON format
 type JSONEntry struct {
-	Date        string  `json:"date"`
-	Consultant  string  `json:"consultant"`
-	Project     string  `json:"project"`
-	Customer    string  `json:"customer"`
-	Description string  `json:"description"`
-	Hours       float64 `json:"hours"`
-	HourlyRate  float64 `json:"hourly_rate"`
-	Cost        float64 `json:"cost"`
+	ID          uint         `json:"id"`
+	Date        string       `json:"date"`
+	StartTime   string       `json:"start_time,omitempty"`
+	EndTime     string       `json:"end_time,omitempty"`
+	Consultant  string       `json:"consultant"`
+	Project     string       `json:"project"`
+	Customer    string       `json:"customer"`
+	Description string       `json:"description"`
+	Hours       float64      `json:"hours"`
+	HourlyRate  models.Money `json:"hourly_rate"`
+	Cost        models.Money `json:"cost"` // exact, or rounded to öre when costs are rounded per line
+	Currency    string       `json:"currency,omitempty"`
+	// VATRate is the VAT in percent charged to the customer, when VAT is included
+	VATRate *float64 `json:"vat_rate,omitempty"`
+}
+
+// JSONEntryDetail represents a single time entry with every field, as written by FormatEntry
+type JSONEntryDetail struct {
+	JSONEntry
+	ProjectDescription string    `json:"project_description"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// JSONSubtotal represents the sum of the entries sharing a grouping key and currency
+type JSONSubtotal struct {
+	Key      string       `json:"key"`
+	Count    int          `json:"count"`
+	Hours    float64      `json:"hours"`
+	Cost     models.Money `json:"cost"`
+	Currency string       `json:"currency"`
+}
+
+// JSONTotal represents the total of the entries in one currency
+type JSONTotal struct {
+	Currency string        `json:"currency"`
+	Cost     models.Money  `json:"cost"`
+	VAT      *models.Money `json:"vat,omitempty"`
+	Gross    *models.Money `json:"gross,omitempty"`
 }
 
 // JSONOutput represents the complete JSON output
 type JSONOutput struct {
 	Entries    []JSONEntry `json:"entries"`
 	TotalHours float64     `json:"total_hours"`
-	TotalCost  float64     `json:"total_cost"`
 	Count      int         `json:"count"`
+	// The total cost and currency are given when all amounts are in one currency, or were
+	// converted into one. Otherwise Totals holds the total of each currency.
+	TotalCost *models.Money `json:"total_cost,omitempty"`
+	Currency  string        `json:"currency,omitempty"`
+	Totals    []JSONTotal   `json:"totals,omitempty"`
+	// The VAT fields are included when VAT is requested. VAT is computed on the total per
+	// customer, as on an invoice.
+	TotalVAT              *models.Money `json:"total_vat,omitempty"`
+	TotalGross            *models.Money `json:"total_gross,omitempty"`
+	ReverseChargeCustomer []string      `json:"reverse_charge_customers,omitempty"`
+	ReverseChargeNote     string        `json:"reverse_charge_note,omitempty"`
+	// Summary holds the subtotals of each requested grouping, keyed by grouping name. The
+	// groupings are independent flat lists, as in the other formats, not nested in each other.
+	Summary map[GroupBy][]JSONSubtotal `json:"summary,omitempty"`
 }
 
 // Format writes entries to the writer in JSON format
 func (f *JSONFormatter) Format(entries []models.TimeEntry, writer io.Writer) error {
 	var jsonEntries []JSONEntry
-	var totalHours float64
-	var totalCost float64
-
 	for _, entry := range entries {
-		cost := entry.Hours * entry.HourlyRate
-		jsonEntry := JSONEntry{
-			Date:        entry.Date.Format("2006-01-02"),
-			Consultant:  entry.Consultant.Name,
-			Project:     entry.Project.Name,
-			Customer:    entry.Project.Customer.Name,
-			Description: entry.Description,
-			Hours:       entry.Hours,
-			HourlyRate:  entry.HourlyRate,
-			Cost:        cost,
+		jsonEntry := f.newJSONEntry(entry)
+		if f.opts.DefaultVATRate != nil {
+			rate := entryVATRate(entry, *f.opts.DefaultVATRate)
+			jsonEntry.VATRate = &rate
 		}
 		jsonEntries = append(jsonEntries, jsonEntry)
-		totalHours += entry.Hours
-		totalCost += cost
+	}
+
+	t, err := f.opts.sumEntries(entries)
+	if err != nil {
+		return err
 	}
 
 	output := JSONOutput{
 		Entries:    jsonEntries,
-		TotalHours: totalHours,
-		TotalCost:  totalCost,
+		TotalHours: t.hours,
 		Count:      len(entries),
 	}
 
+	if len(t.cost) == 1 {
+		output.TotalCost = &t.cost[0].amount
+		output.Currency = t.cost[0].currency
+		if f.opts.DefaultVATRate != nil {
+			output.TotalVAT = &t.vat[0].amount
+			output.TotalGross = &t.gross[0].amount
+		}
+	} else {
+		for i, cost := range t.cost {
+			total := JSONTotal{Currency: cost.currency, Cost: cost.amount}
+			if f.opts.DefaultVATRate != nil {
+				total.VAT = &t.vat[i].amount
+				total.Gross = &t.gross[i].amount
+			}
+			output.Totals = append(output.Totals, total)
+		}
+	}
+	if len(t.reverseCharge) > 0 {
+		output.ReverseChargeCustomer = t.reverseCharge
+		output.ReverseChargeNote = i18n.T(i18n.KeyVATReverseChargeNote)
+	}
+
+	for _, by := range f.opts.GroupBy {
+		if output.Summary == nil {
+			output.Summary = make(map[GroupBy][]JSONSubtotal)
+		}
+		subtotals, err := f.opts.groupEntries(entries, by)
+		if err != nil {
+			return err
+		}
+		for _, sub := range subtotals {
+			output.Summary[by] = append(output.Summary[by], JSONSubtotal{
+				Key:      sub.key,
+				Count:    sub.count,
+				Hours:    sub.hours,
+				Cost:     sub.cost.amount.Round(),
+				Currency: sub.cost.currency,
+			})
+		}
+	}
+
 	encoder := json.NewEncoder(writer)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(output)
 }
+
+// FormatEntry writes every field of a single entry as a JSON object
+func (f *JSONFormatter) FormatEntry(entry models.TimeEntry, writer io.Writer) error {
+	detail := JSONEntryDetail{
+		JSONEntry:          f.newJSONEntry(entry),
+		ProjectDescription: entry.Project.Description,
+		CreatedAt:          entry.CreatedAt,
+		UpdatedAt:          entry.UpdatedAt,
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(detail)
+}
+
+func (f *JSONFormatter) newJSONEntry(entry models.TimeEntry) JSONEntry {
+	return JSONEntry{
+		ID:          entry.ID,
+		Date:        entry.Date.Format("2006-01-02"),
+		StartTime:   FormatClock(entry.StartTime),
+		EndTime:     FormatClock(entry.EndTime),
+		Consultant:  entry.Consultant.Name,
+		Project:     entry.Project.Name,
+		Customer:    entry.Project.Customer.Name,
+		Description: entry.Description,
+		Hours:       entry.Hours,
+		HourlyRate:  entry.HourlyRate,
+		Cost:        f.opts.lineCost(entry),
+		Currency:    entryCurrency(entry),
+	}
+}
@@ -0,0 +1,326 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/config"
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Layout of the A4 page in millimetres
+const (
+	pdfMargin     = 20.0
+	pdfWidth      = 210.0 - 2*pdfMargin
+	pdfLineHeight = 5.0
+	pdfFooterTop  = 297.0 - 32.0
+)
+
+// Widths of the line item columns: description, hours, rate and amount
+var pdfColumns = []float64{85, 25, 30, 30}
+
+// invoiceLine is the work of one consultant on a project at one hourly rate
+type invoiceLine struct {
+	Consultant string
+	HourlyRate models.Money
+	Hours      float64
+	Amount     models.Money
+}
+
+// invoiceProject holds the lines of one project in the order they were first worked
+type invoiceProject struct {
+	Name  string
+	Lines []invoiceLine
+}
+
+// groupInvoiceLines sums the entries of an invoice per project, consultant and hourly rate.
+// The cost of each entry is rounded as the invoice says before it is added to its line.
+func groupInvoiceLines(invoice models.Invoice) []invoiceProject {
+	var projects []invoiceProject
+	for _, entry := range invoice.TimeEntries {
+		p := 0
+		for p < len(projects) && projects[p].Name != entry.Project.Name {
+			p++
+		}
+		if p == len(projects) {
+			projects = append(projects, invoiceProject{Name: entry.Project.Name})
+		}
+		project := &projects[p]
+
+		l := 0
+		consultant := entry.Consultant.InvoiceName()
+		for l < len(project.Lines) && (project.Lines[l].Consultant != consultant || project.Lines[l].HourlyRate != entry.HourlyRate) {
+			l++
+		}
+		if l == len(project.Lines) {
+			project.Lines = append(project.Lines, invoiceLine{Consultant: consultant, HourlyRate: entry.HourlyRate})
+		}
+		project.Lines[l].Hours += entry.Hours
+		project.Lines[l].Amount += invoice.Rounding.LineCost(entry.Hours, entry.HourlyRate)
+	}
+	return projects
+}
+
+// invoicePDF renders one invoice. Texts and numbers follow the language of the document,
+// not the language of the user running the command.
+type invoicePDF struct {
+	pdf      *gofpdf.Fpdf
+	lang     string
+	currency string
+	printer  *message.Printer
+	encode   func(string) string
+}
+
+// WriteInvoicePDF writes an invoice as an A4 PDF document in the given language ("sv" or
+// "en"). The entries of the invoice must be loaded with their projects and consultants.
+func WriteInvoicePDF(invoice models.Invoice, seller config.Seller, lang string, writer io.Writer) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	r := &invoicePDF{
+		pdf:      pdf,
+		lang:     lang,
+		currency: invoice.Currency,
+		printer:  message.NewPrinter(language.Make(lang)),
+		// The built-in fonts are encoded as Windows-1252, which covers å, ä and ö
+		encode: pdf.UnicodeTranslatorFromDescriptor(""),
+	}
+
+	title := r.t(i18n.KeyInvoicePDFTitle)
+	pdf.SetTitle(title+" "+invoice.Number, true)
+	pdf.SetAuthor(seller.Name, true)
+	pdf.SetCreator("worklog", false)
+	pdf.SetCreationDate(invoice.IssueDate)
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() { r.footer(seller, invoice.Number) })
+	pdf.AddPage()
+
+	r.header(invoice, seller, title)
+	r.lines(invoice)
+	r.totals(invoice)
+
+	if err := pdf.Output(writer); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWritePDF), err)
+	}
+	return nil
+}
+
+// t returns the message in the language of the document
+func (r *invoicePDF) t(key string) string {
+	return i18n.TranslationIn(r.lang, key)
+}
+
+func (r *invoicePDF) number(value float64) string {
+	return r.printer.Sprintf("%.2f", value)
+}
+
+// money formats an amount rounded to öre, which a float prints exactly with two decimals
+func (r *invoicePDF) money(value models.Money) string {
+	return r.number(value.Round().Float64()) + " " + currency.Symbol(r.currency)
+}
+
+// cell writes text in a cell of the given width; align is "L", "C" or "R"
+func (r *invoicePDF) cell(width float64, text, align string) {
+	r.pdf.CellFormat(width, pdfLineHeight, r.encode(text), "", 0, align, false, 0, "")
+}
+
+// line writes text in a cell of the given width and moves to the next line
+func (r *invoicePDF) line(width float64, text, align string) {
+	r.pdf.CellFormat(width, pdfLineHeight, r.encode(text), "", 1, align, false, 0, "")
+}
+
+func (r *invoicePDF) rule() {
+	y := r.pdf.GetY()
+	r.pdf.Line(pdfMargin, y, pdfMargin+pdfWidth, y)
+}
+
+// header writes the seller, the title, the invoice details and the customer
+func (r *invoicePDF) header(invoice models.Invoice, seller config.Seller, title string) {
+	pdf := r.pdf
+	top := pdf.GetY()
+
+	pdf.SetFont("Helvetica", "B", 12)
+	r.line(pdfWidth/2, seller.Name, "L")
+	pdf.SetFont("Helvetica", "", 10)
+	for _, row := range strings.Split(seller.Address, "\n") {
+		if row != "" {
+			r.line(pdfWidth/2, row, "L")
+		}
+	}
+	left := pdf.GetY()
+
+	pdf.SetXY(pdfMargin+pdfWidth/2, top)
+	pdf.SetFont("Helvetica", "B", 20)
+	r.line(pdfWidth/2, strings.ToUpper(title), "R")
+	pdf.Ln(3)
+
+	details := [][2]string{
+		{r.t(i18n.KeyInvoiceFieldNumber), invoice.Number},
+		{r.t(i18n.KeyInvoiceFieldIssueDate), invoice.IssueDate.Format("2006-01-02")},
+		{r.t(i18n.KeyInvoiceFieldDueDate), invoice.DueDate.Format("2006-01-02")},
+		{r.t(i18n.KeyInvoiceFieldPeriod), invoicePeriod(invoice)},
+	}
+	for _, detail := range details {
+		pdf.SetX(pdfMargin + pdfWidth/2)
+		pdf.SetFont("Helvetica", "B", 10)
+		r.cell(35, detail[0], "L")
+		pdf.SetFont("Helvetica", "", 10)
+		r.line(pdfWidth/2-35, detail[1], "R")
+	}
+
+	pdf.SetXY(pdfMargin, max(left, pdf.GetY())+10)
+	pdf.SetFont("Helvetica", "B", 10)
+	r.line(pdfWidth, r.t(i18n.KeyInvoicePDFBillTo), "L")
+	pdf.SetFont("Helvetica", "", 10)
+	r.line(pdfWidth, invoice.Customer.Name, "L")
+	if invoice.Customer.VATNumber != "" {
+		r.line(pdfWidth, r.t(i18n.KeyInvoicePDFVATNumber)+": "+invoice.Customer.VATNumber, "L")
+	}
+	pdf.Ln(10)
+}
+
+// columnHeader writes the headings of the line items
+func (r *invoicePDF) columnHeader() {
+	r.pdf.SetFont("Helvetica", "B", 10)
+	headings := []string{
+		r.t(i18n.KeyInvoicePDFDescription),
+		r.t(i18n.KeyInvoicePDFHours),
+		r.t(i18n.KeyInvoicePDFRate),
+		r.t(i18n.KeyInvoicePDFAmount),
+	}
+	for col, heading := range headings {
+		align := "R"
+		if col == 0 {
+			align = "L"
+		}
+		r.cell(pdfColumns[col], heading, align)
+	}
+	r.pdf.Ln(pdfLineHeight + 1)
+	r.rule()
+	r.pdf.Ln(1)
+	r.pdf.SetFont("Helvetica", "", 10)
+}
+
+// ensureSpace starts a new page with the column headings when fewer than rows lines fit
+// above the footer, and reports whether it did
+func (r *invoicePDF) ensureSpace(rows int) bool {
+	if r.pdf.GetY()+float64(rows)*pdfLineHeight <= pdfFooterTop-5 {
+		return false
+	}
+	r.pdf.AddPage()
+	r.columnHeader()
+	return true
+}
+
+// lines writes the line items grouped by project
+func (r *invoicePDF) lines(invoice models.Invoice) {
+	pdf := r.pdf
+	r.columnHeader()
+
+	for _, project := range groupInvoiceLines(invoice) {
+		// A project heading is never left alone at the bottom of a page
+		r.ensureSpace(2)
+		pdf.SetFont("Helvetica", "B", 10)
+		r.line(pdfWidth, project.Name, "L")
+		pdf.SetFont("Helvetica", "", 10)
+
+		for _, item := range project.Lines {
+			r.ensureSpace(1)
+			r.cell(pdfColumns[0], "   "+item.Consultant, "L")
+			r.cell(pdfColumns[1], r.number(item.Hours), "R")
+			r.cell(pdfColumns[2], r.money(item.HourlyRate), "R")
+			r.line(pdfColumns[3], r.money(item.Amount), "R")
+		}
+		pdf.Ln(2)
+	}
+}
+
+// totals writes the amount before VAT, the VAT and the amount to pay
+func (r *invoicePDF) totals(invoice models.Invoice) {
+	pdf := r.pdf
+	net, vat, gross := invoice.Amounts()
+
+	// The column headings of a new page already end with a rule
+	if !r.ensureSpace(8) {
+		r.rule()
+		pdf.Ln(2)
+	}
+
+	labelWidth := pdfColumns[1] + pdfColumns[2]
+	indent := pdfWidth - labelWidth - pdfColumns[3]
+	rows := [][2]string{
+		{r.t(i18n.KeyInvoicePDFNet), r.money(net)},
+		{fmt.Sprintf(r.t(i18n.KeyVATRate), r.printer.Sprintf("%g", invoice.VATRate)), r.money(vat)},
+	}
+	for _, row := range rows {
+		pdf.SetX(pdfMargin + indent)
+		r.cell(labelWidth, row[0], "L")
+		r.line(pdfColumns[3], row[1], "R")
+	}
+
+	pdf.SetX(pdfMargin + indent)
+	pdf.SetFont("Helvetica", "B", 11)
+	r.cell(labelWidth, r.t(i18n.KeyInvoicePDFTotal), "L")
+	r.line(pdfColumns[3], r.money(gross), "R")
+
+	if invoice.ReverseCharge {
+		pdf.Ln(6)
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.MultiCell(pdfWidth, pdfLineHeight, r.encode(r.t(i18n.KeyVATReverseChargeNote)), "", "L", false)
+	}
+}
+
+// footer writes the payment and contact details of the seller and the page number
+func (r *invoicePDF) footer(seller config.Seller, number string) {
+	pdf := r.pdf
+	pdf.SetXY(pdfMargin, pdfFooterTop)
+	r.rule()
+	pdf.Ln(2)
+
+	columns := [][]string{
+		r.labelled([][2]string{
+			{i18n.KeyInvoicePDFOrgNumber, seller.OrgNumber},
+			{i18n.KeyInvoicePDFVATNumber, seller.VATNumber},
+		}),
+		r.labelled([][2]string{
+			{i18n.KeyInvoicePDFEmail, seller.Email},
+			{i18n.KeyInvoicePDFPhone, seller.Phone},
+		}),
+		r.labelled([][2]string{
+			{i18n.KeyInvoicePDFBankgiro, seller.Bankgiro},
+			{i18n.KeyInvoicePDFIBAN, seller.IBAN},
+			{i18n.KeyInvoicePDFBIC, seller.BIC},
+		}),
+	}
+
+	pdf.SetFont("Helvetica", "", 8)
+	top := pdf.GetY()
+	for col, rows := range columns {
+		pdf.SetY(top)
+		for _, row := range rows {
+			pdf.SetX(pdfMargin + float64(col)*pdfWidth/3)
+			r.pdf.CellFormat(pdfWidth/3, 4, r.encode(row), "", 1, "L", false, 0, "")
+		}
+	}
+
+	pdf.SetXY(pdfMargin, top+13)
+	r.cell(pdfWidth/2, fmt.Sprintf(r.t(i18n.KeyInvoicePDFReference), number), "L")
+	r.cell(pdfWidth/2, fmt.Sprintf(r.t(i18n.KeyInvoicePDFPage), pdf.PageNo(), "{nb}"), "R")
+}
+
+// labelled formats the non-empty values as "Label: value"
+func (r *invoicePDF) labelled(fields [][2]string) []string {
+	var rows []string
+	for _, field := range fields {
+		if field[1] != "" {
+			rows = append(rows, r.t(field[0])+": "+field[1])
+		}
+	}
+	return rows
+}
@@ -0,0 +1,99 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// FormatProjects writes projects as a table with their code, customer and the hours logged
+// to date. The customer of each project must be loaded.
+func FormatProjects(projects []models.Project, activity map[uint]models.Activity, writer io.Writer) error {
+	header := []string{
+		i18n.T(i18n.KeyGetHeaderProject),
+		i18n.T(i18n.KeyEntityHeaderCode),
+		i18n.T(i18n.KeyGetHeaderCustomer),
+		i18n.T(i18n.KeyEntityHeaderStatus),
+		i18n.T(i18n.KeyGetHeaderHours),
+		i18n.T(i18n.KeyEntityHeaderLastEntry),
+		i18n.T(i18n.KeyGetHeaderDescription),
+	}
+	// The hours column holds numbers and is right aligned
+	const hoursColumn = 4
+
+	rows := [][]string{header}
+	for _, project := range projects {
+		rows = append(rows, []string{
+			project.Name,
+			project.CodeString(),
+			project.Customer.Name,
+			ActiveLabel(project.Active && project.Customer.Active),
+			fmt.Sprintf("%.2f", activity[project.ID].Hours),
+			lastEntry(activity[project.ID]),
+			truncate(project.Description, 40),
+		})
+	}
+
+	widths := make([]int, len(header))
+	for _, row := range rows {
+		for col, cell := range row {
+			widths[col] = max(widths[col], utf8.RuneCountInString(cell))
+		}
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for col, cell := range row {
+			if col == hoursColumn {
+				cells[col] = strings.Repeat(" ", widths[col]-utf8.RuneCountInString(cell)) + cell
+			} else {
+				cells[col] = padRight(cell, widths[col])
+			}
+		}
+		fmt.Fprintln(writer, strings.TrimRight(strings.Join(cells, "   "), " "))
+	}
+
+	return nil
+}
+
+// FormatProject writes the settings of a project and the work logged to date. The customer
+// of the project must be loaded.
+func FormatProject(project models.Project, activity models.Activity, writer io.Writer) error {
+	status := ActiveLabel(project.Active)
+	if project.Active && !project.Customer.Active {
+		status = fmt.Sprintf(i18n.T(i18n.KeyProjectCustomerArchived), ActiveLabel(false))
+	}
+
+	fields := []struct {
+		label string
+		value string
+	}{
+		{i18n.T(i18n.KeyFieldProject), project.Name},
+		{i18n.T(i18n.KeyFieldCode), project.CodeString()},
+		{i18n.T(i18n.KeyFieldCustomer), project.Customer.Name},
+		{i18n.T(i18n.KeyFieldStatus), status},
+		{i18n.T(i18n.KeyFieldDescription), project.Description},
+		{i18n.T(i18n.KeyFieldEntries), fmt.Sprintf("%d", activity.Entries)},
+		{i18n.T(i18n.KeyFieldHours), fmt.Sprintf("%.2f", activity.Hours)},
+		{i18n.T(i18n.KeyFieldFirstEntry), firstEntry(activity)},
+		{i18n.T(i18n.KeyFieldLastEntry), lastEntry(activity)},
+		{i18n.T(i18n.KeyFieldCreatedAt), project.CreatedAt.In(time.Local).Format(timestampLayout)},
+	}
+
+	labelWidth := 0
+	for _, field := range fields {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(field.label))
+	}
+
+	for _, field := range fields {
+		padding := labelWidth - utf8.RuneCountInString(field.label)
+		fmt.Fprintf(writer, "%s:%*s %s\n", field.label, padding, "", field.value)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// FormatRateCards writes rate cards as a table of who they apply to, the rate and the days
+// it is valid. The consultant, customer and project of each card must be loaded.
+func FormatRateCards(cards []models.RateCard, writer io.Writer) error {
+	header := []string{
+		i18n.T(i18n.KeyGetHeaderConsultant),
+		i18n.T(i18n.KeyGetHeaderCustomer),
+		i18n.T(i18n.KeyGetHeaderProject),
+		i18n.T(i18n.KeyGetHeaderRate),
+		i18n.T(i18n.KeyRateHeaderFrom),
+		i18n.T(i18n.KeyRateHeaderTo),
+	}
+	// The rate column holds numbers and is right aligned
+	const rateColumn = 3
+
+	anyone := i18n.T(i18n.KeyRateAny)
+	rows := [][]string{header}
+	for _, card := range cards {
+		consultant, customerName, project := anyone, anyone, anyone
+		if card.Consultant != nil {
+			consultant = card.Consultant.Name
+		}
+		if card.Project != nil {
+			project = card.Project.Name
+		}
+		rate := card.HourlyRate.String()
+		if customer := card.BilledCustomer(); customer != nil {
+			customerName = customer.Name
+			rate = currency.Format(card.HourlyRate, customer.CurrencyCode())
+		}
+		to := ""
+		if card.ValidTo != nil {
+			to = card.ValidTo.Format("2006-01-02")
+		}
+		rows = append(rows, []string{
+			consultant,
+			customerName,
+			project,
+			rate,
+			card.ValidFrom.Format("2006-01-02"),
+			to,
+		})
+	}
+
+	widths := make([]int, len(header))
+	for _, row := range rows {
+		for col, cell := range row {
+			widths[col] = max(widths[col], utf8.RuneCountInString(cell))
+		}
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for col, cell := range row {
+			if col == rateColumn {
+				cells[col] = strings.Repeat(" ", widths[col]-utf8.RuneCountInString(cell)) + cell
+			} else {
+				cells[col] = padRight(cell, widths[col])
+			}
+		}
+		fmt.Fprintln(writer, strings.TrimRight(strings.Join(cells, "   "), " "))
+	}
+
+	return nil
+}
+
+// RateChange is a time entry whose hourly rate is about to change
+type RateChange struct {
+	Entry   models.TimeEntry
+	NewRate models.Money
+}
+
+// Delta returns how much the cost of the entry changes, under the rounding rule
+func (c RateChange) Delta(rounding models.Rounding) models.Money {
+	return rounding.LineCost(c.Entry.Hours, c.NewRate) - rounding.LineCost(c.Entry.Hours, c.Entry.HourlyRate)
+}
+
+// FormatRateChanges writes time entries whose rate changes as a table with the current and
+// the new rate and the change in cost. The project, customer and consultant of each entry
+// must be loaded.
+func FormatRateChanges(changes []RateChange, rounding models.Rounding, writer io.Writer) error {
+	header := []string{
+		i18n.T(i18n.KeyGetHeaderID),
+		i18n.T(i18n.KeyGetHeaderDate),
+		i18n.T(i18n.KeyGetHeaderConsultant),
+		i18n.T(i18n.KeyGetHeaderCustomer),
+		i18n.T(i18n.KeyGetHeaderProject),
+		i18n.T(i18n.KeyGetHeaderDescription),
+		i18n.T(i18n.KeyGetHeaderHours),
+		i18n.T(i18n.KeyGetHeaderRate),
+		i18n.T(i18n.KeyRateApplyHeaderNewRate),
+		i18n.T(i18n.KeyRateApplyHeaderChange),
+	}
+	// The last four columns hold numbers and are right aligned
+	const textColumns = 6
+
+	rows := [][]string{header}
+	for _, change := range changes {
+		entry := change.Entry
+		code := entry.Project.Customer.CurrencyCode()
+		delta := currency.Format(change.Delta(rounding), code)
+		if change.Delta(rounding).Round() > 0 {
+			delta = "+" + delta
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", entry.ID),
+			entry.Date.Format("2006-01-02"),
+			entry.Consultant.Name,
+			entry.Project.Customer.Name,
+			entry.Project.Name,
+			truncate(entry.Description, 40),
+			fmt.Sprintf("%.2f", entry.Hours),
+			currency.Format(entry.HourlyRate, code),
+			currency.Format(change.NewRate, code),
+			delta,
+		})
+	}
+
+	widths := make([]int, len(header))
+	for _, row := range rows {
+		for col, cell := range row {
+			widths[col] = max(widths[col], utf8.RuneCountInString(cell))
+		}
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for col, cell := range row {
+			if col < textColumns {
+				cells[col] = padRight(cell, widths[col])
+			} else {
+				cells[col] = strings.Repeat(" ", widths[col]-utf8.RuneCountInString(cell)) + cell
+			}
+		}
+		fmt.Fprintln(writer, strings.Join(cells, "   "))
+	}
+
+	return nil
+}
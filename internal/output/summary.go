@@ -0,0 +1,118 @@
+package output
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// GroupBy names a dimension that entries can be subtotalled by
+type GroupBy string
+
+const (
+	GroupByConsultant GroupBy = "consultant"
+	GroupByProject    GroupBy = "project"
+	GroupByCustomer   GroupBy = "customer"
+	GroupByDate       GroupBy = "date"
+	GroupByWeek       GroupBy = "week"
+	GroupByMonth      GroupBy = "month"
+)
+
+// DefaultGroupBy lists the groupings shown by a summary when none are chosen
+var DefaultGroupBy = []GroupBy{GroupByConsultant, GroupByProject, GroupByCustomer}
+
+// ParseGroupBy parses a comma separated list of groupings, e.g. "consultant,week"
+func ParseGroupBy(value string) ([]GroupBy, error) {
+	var groupings []GroupBy
+	for _, part := range strings.Split(value, ",") {
+		by := GroupBy(strings.ToLower(strings.TrimSpace(part)))
+		switch by {
+		case GroupByConsultant, GroupByProject, GroupByCustomer, GroupByDate, GroupByWeek, GroupByMonth:
+			groupings = append(groupings, by)
+		default:
+			return nil, fmt.Errorf(i18n.T(i18n.KeyErrInvalidGroupBy), part)
+		}
+	}
+	return groupings, nil
+}
+
+// label returns the translated name of the grouping, used as column header
+func (g GroupBy) label() string {
+	switch g {
+	case GroupByConsultant:
+		return i18n.T(i18n.KeyGetHeaderConsultant)
+	case GroupByProject:
+		return i18n.T(i18n.KeyGetHeaderProject)
+	case GroupByCustomer:
+		return i18n.T(i18n.KeyGetHeaderCustomer)
+	case GroupByDate:
+		return i18n.T(i18n.KeyGetHeaderDate)
+	case GroupByWeek:
+		return i18n.T(i18n.KeyGetHeaderWeek)
+	default:
+		return i18n.T(i18n.KeyGetHeaderMonth)
+	}
+}
+
+// key returns the value of the grouping for an entry. Periods are formatted so that
+// they sort chronologically; projects include their customer since names are only
+// unique per customer.
+func (g GroupBy) key(entry models.TimeEntry) string {
+	switch g {
+	case GroupByConsultant:
+		return entry.Consultant.Name
+	case GroupByProject:
+		return fmt.Sprintf("%s (%s)", entry.Project.Name, entry.Project.Customer.Name)
+	case GroupByCustomer:
+		return entry.Project.Customer.Name
+	case GroupByDate:
+		return entry.Date.Format("2006-01-02")
+	case GroupByWeek:
+		year, week := entry.Date.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	default:
+		return entry.Date.Format("2006-01")
+	}
+}
+
+// subtotal is the sum of the entries sharing a grouping key and currency. The first of
+// those entries is kept to show the names behind the key.
+type subtotal struct {
+	key   string
+	first models.TimeEntry
+	count int
+	hours float64
+	cost  money
+}
+
+// groupEntries sums entries per key of the grouping, ordered by key. Entries in different
+// currencies get a subtotal each, unless the options convert them into one currency.
+func (o Options) groupEntries(entries []models.TimeEntry, by GroupBy) ([]subtotal, error) {
+	type group struct{ key, currency string }
+	index := make(map[group]int)
+	var subtotals []subtotal
+
+	for _, entry := range entries {
+		cost, err := o.convert(o.lineCost(entry), entryCurrency(entry))
+		if err != nil {
+			return nil, err
+		}
+
+		key := group{by.key(entry), cost.currency}
+		i, exists := index[key]
+		if !exists {
+			i = len(subtotals)
+			index[key] = i
+			subtotals = append(subtotals, subtotal{key: key.key, first: entry, cost: money{currency: cost.currency}})
+		}
+		subtotals[i].count++
+		subtotals[i].hours += entry.Hours
+		subtotals[i].cost.amount += cost.amount
+	}
+
+	sort.SliceStable(subtotals, func(i, j int) bool { return subtotals[i].key < subtotals[j].key })
+	return subtotals, nil
+}
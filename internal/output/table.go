@@ -3,23 +3,28 @@ package output
 import (
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/LimerDev/worklog/internal/currency"
 	"github.com/LimerDev/worklog/internal/i18n"
 	"github.com/LimerDev/worklog/internal/models"
 )
 
 // TableFormatter formats entries as a table
-type TableFormatter struct{}
-
-type entrySummary struct {
-	hours float64
-	cost  float64
+type TableFormatter struct {
+	opts Options
 }
 
 // Format writes entries to the writer in table format
 func (f *TableFormatter) Format(entries []models.TimeEntry, writer io.Writer) error {
 	// Calculate column widths dynamically based on translated headers
+	idWidth := len(i18n.T(i18n.KeyGetHeaderID))
 	dateWidth := len(i18n.T(i18n.KeyGetHeaderDate))
+	startWidth := max(len(i18n.T(i18n.KeyGetHeaderStart)), len(clockLayout))
+	endWidth := max(len(i18n.T(i18n.KeyGetHeaderEnd)), len(clockLayout))
 	consultantWidth := len(i18n.T(i18n.KeyGetHeaderConsultant))
 	projectWidth := len(i18n.T(i18n.KeyGetHeaderProject))
 	customerWidth := len(i18n.T(i18n.KeyGetHeaderCustomer))
@@ -36,8 +41,17 @@ func (f *TableFormatter) Format(entries []models.TimeEntry, writer io.Writer) er
 		maxDescriptionWidth = 40
 	)
 
+	// Start and end columns are only shown when some entry records times
+	showTimes := false
 	for _, entry := range entries {
+		if entry.HasTimes() {
+			showTimes = true
+		}
+
 		// Text columns
+		if len(strconv.FormatUint(uint64(entry.ID), 10)) > idWidth {
+			idWidth = len(strconv.FormatUint(uint64(entry.ID), 10))
+		}
 		if len(entry.Date.Format("2006-01-02")) > dateWidth {
 			dateWidth = len(entry.Date.Format("2006-01-02"))
 		}
@@ -59,11 +73,11 @@ func (f *TableFormatter) Format(entries []models.TimeEntry, writer io.Writer) er
 		if len(hoursStr) > hoursWidth {
 			hoursWidth = len(hoursStr)
 		}
-		rateStr := fmt.Sprintf("%.2f", entry.HourlyRate)
+		rateStr := entry.HourlyRate.String()
 		if len(rateStr) > rateWidth {
 			rateWidth = len(rateStr)
 		}
-		costStr := fmt.Sprintf("%.2f", entry.Hours*entry.HourlyRate)
+		costStr := currency.Format(f.opts.lineCost(entry), entryCurrency(entry))
 		if len(costStr) > costWidth {
 			costWidth = len(costStr)
 		}
@@ -86,15 +100,15 @@ func (f *TableFormatter) Format(entries []models.TimeEntry, writer io.Writer) er
 		descriptionWidth = maxDescriptionWidth
 	}
 
-	consultantStats := make(map[string]*entrySummary)
-	projectStats := make(map[string]*entrySummary)
-	customerStats := make(map[string]*entrySummary)
-	var totalHours float64
-	var totalCost float64
-
 	// Print header
-	fmt.Fprintf(writer, "%-*s   %-*s   %-*s   %-*s   %-*s   %-*s   %-*s   %-*s\n",
-		dateWidth, i18n.T(i18n.KeyGetHeaderDate),
+	if f.opts.ShowIDs {
+		fmt.Fprintf(writer, "%-*s   ", idWidth, i18n.T(i18n.KeyGetHeaderID))
+	}
+	fmt.Fprintf(writer, "%-*s   ", dateWidth, i18n.T(i18n.KeyGetHeaderDate))
+	if showTimes {
+		fmt.Fprintf(writer, "%-*s   %-*s   ", startWidth, i18n.T(i18n.KeyGetHeaderStart), endWidth, i18n.T(i18n.KeyGetHeaderEnd))
+	}
+	fmt.Fprintf(writer, "%-*s   %-*s   %-*s   %-*s   %-*s   %-*s   %-*s\n",
 		consultantWidth, i18n.T(i18n.KeyGetHeaderConsultant),
 		hoursWidth, i18n.T(i18n.KeyGetHeaderHours),
 		rateWidth, i18n.T(i18n.KeyGetHeaderRate),
@@ -108,50 +122,125 @@ func (f *TableFormatter) Format(entries []models.TimeEntry, writer io.Writer) er
 		customerName := entry.Project.Customer.Name
 		consultantName := entry.Consultant.Name
 		hourlyRate := entry.HourlyRate
-		cost := entry.Hours * hourlyRate
+		cost := currency.Format(f.opts.lineCost(entry), entryCurrency(entry))
 
-		fmt.Fprintf(writer, "%-*s   %-*s   %-*.2f   %-*.2f   %-*.2f   %-*s   %-*s   %-*s\n",
-			dateWidth, entry.Date.Format("2006-01-02"),
+		if f.opts.ShowIDs {
+			fmt.Fprintf(writer, "%-*d   ", idWidth, entry.ID)
+		}
+		fmt.Fprintf(writer, "%-*s   ", dateWidth, entry.Date.Format("2006-01-02"))
+		if showTimes {
+			fmt.Fprintf(writer, "%-*s   %-*s   ", startWidth, FormatClock(entry.StartTime), endWidth, FormatClock(entry.EndTime))
+		}
+		fmt.Fprintf(writer, "%-*s   %-*.2f   %-*s   %-*s   %-*s   %-*s   %-*s\n",
 			consultantWidth, truncate(consultantName, consultantWidth),
 			hoursWidth, entry.Hours,
-			rateWidth, hourlyRate,
+			rateWidth, hourlyRate.String(),
 			costWidth, cost,
 			projectWidth, truncate(projectName, projectWidth),
 			customerWidth, truncate(customerName, customerWidth),
 			descriptionWidth, truncate(entry.Description, descriptionWidth))
+	}
 
-		// Aggregate statistics
-		projectName = entry.Project.Name
-		customerName = entry.Project.Customer.Name
-		consultantName = entry.Consultant.Name
-		totalHours += entry.Hours
-		totalCost += cost
+	t, err := f.opts.sumEntries(entries)
+	if err != nil {
+		return err
+	}
 
-		if _, exists := consultantStats[consultantName]; !exists {
-			consultantStats[consultantName] = &entrySummary{}
+	// Each currency has its own total line
+	fmt.Fprintf(writer, "\n%s: %.2f\n", i18n.T(i18n.KeyGetTotalHours), t.hours)
+	for _, cost := range t.cost {
+		fmt.Fprintf(writer, "%s: %s\n", i18n.T(i18n.KeyGetTotalCost), cost)
+	}
+	if f.opts.DefaultVATRate != nil {
+		for _, vat := range t.vat {
+			fmt.Fprintf(writer, "%s: %s\n", i18n.T(i18n.KeyVATTotal), vat)
+		}
+		for _, gross := range t.gross {
+			fmt.Fprintf(writer, "%s: %s\n", i18n.T(i18n.KeyVATGross), gross)
 		}
-		consultantStats[consultantName].hours += entry.Hours
-		consultantStats[consultantName].cost += cost
+		for _, customer := range t.reverseCharge {
+			fmt.Fprintf(writer, "%s: %s\n", customer, i18n.T(i18n.KeyVATReverseChargeNote))
+		}
+	}
 
-		if _, exists := projectStats[projectName]; !exists {
-			projectStats[projectName] = &entrySummary{}
+	for _, by := range f.opts.GroupBy {
+		if err := f.formatSubtotals(entries, by, writer); err != nil {
+			return err
 		}
-		projectStats[projectName].hours += entry.Hours
-		projectStats[projectName].cost += cost
+	}
 
-		if _, exists := customerStats[customerName]; !exists {
-			customerStats[customerName] = &entrySummary{}
+	return nil
+}
+
+// formatSubtotals writes the hours and cost per key of a grouping as a table section
+func (f *TableFormatter) formatSubtotals(entries []models.TimeEntry, by GroupBy, writer io.Writer) error {
+	subtotals, err := f.opts.groupEntries(entries, by)
+	if err != nil {
+		return err
+	}
+
+	keyWidth := utf8.RuneCountInString(by.label())
+	hoursWidth := utf8.RuneCountInString(i18n.T(i18n.KeyGetHeaderHours))
+	costWidth := utf8.RuneCountInString(i18n.T(i18n.KeyGetHeaderCost))
+	for _, sub := range subtotals {
+		keyWidth = max(keyWidth, utf8.RuneCountInString(sub.key))
+		hoursWidth = max(hoursWidth, len(fmt.Sprintf("%.2f", sub.hours)))
+		costWidth = max(costWidth, len(sub.cost.String()))
+	}
+
+	fmt.Fprintf(writer, "\n%s\n", fmt.Sprintf(i18n.T(i18n.KeyGetSummaryTitle), strings.ToLower(by.label())))
+	fmt.Fprintf(writer, "%s   %*s   %*s\n",
+		padRight(by.label(), keyWidth),
+		hoursWidth, i18n.T(i18n.KeyGetHeaderHours),
+		costWidth, i18n.T(i18n.KeyGetHeaderCost))
+	for _, sub := range subtotals {
+		fmt.Fprintf(writer, "%s   %*.2f   %*s\n", padRight(sub.key, keyWidth), hoursWidth, sub.hours, costWidth, sub.cost)
+	}
+	return nil
+}
+
+// FormatEntry writes every field of a single entry as a list of labelled lines
+func (f *TableFormatter) FormatEntry(entry models.TimeEntry, writer io.Writer) error {
+	fields := []struct {
+		label string
+		value string
+	}{
+		{i18n.T(i18n.KeyFieldID), strconv.FormatUint(uint64(entry.ID), 10)},
+		{i18n.T(i18n.KeyFieldDate), entry.Date.Format("2006-01-02")},
+		{i18n.T(i18n.KeyFieldStart), FormatClock(entry.StartTime)},
+		{i18n.T(i18n.KeyFieldEnd), FormatClock(entry.EndTime)},
+		{i18n.T(i18n.KeyFieldConsultant), entry.Consultant.Name},
+		{i18n.T(i18n.KeyFieldHours), fmt.Sprintf("%.2f", entry.Hours)},
+		{i18n.T(i18n.KeyFieldRate), entry.HourlyRate.String()},
+		{i18n.T(i18n.KeyFieldCost), currency.Format(f.opts.lineCost(entry), entryCurrency(entry))},
+		{i18n.T(i18n.KeyFieldProject), entry.Project.Name},
+		{i18n.T(i18n.KeyFieldProjectDescription), entry.Project.Description},
+		{i18n.T(i18n.KeyFieldCustomer), entry.Project.Customer.Name},
+		{i18n.T(i18n.KeyFieldDescription), entry.Description},
+		{i18n.T(i18n.KeyFieldCreatedAt), entry.CreatedAt.In(time.Local).Format(timestampLayout)},
+		{i18n.T(i18n.KeyFieldUpdatedAt), entry.UpdatedAt.In(time.Local).Format(timestampLayout)},
+	}
+
+	labelWidth := 0
+	for _, field := range fields {
+		if n := utf8.RuneCountInString(field.label); n > labelWidth {
+			labelWidth = n
 		}
-		customerStats[customerName].hours += entry.Hours
-		customerStats[customerName].cost += cost
 	}
 
-	fmt.Fprintf(writer, "\n%s: %.2f\n", i18n.T(i18n.KeyGetTotalHours), totalHours)
-	fmt.Fprintf(writer, "%s: %.2f kr\n", i18n.T(i18n.KeyGetTotalCost), totalCost)
+	for _, field := range fields {
+		padding := labelWidth - utf8.RuneCountInString(field.label)
+		fmt.Fprintf(writer, "%s:%*s %s\n", field.label, padding, "", field.value)
+	}
 
 	return nil
 }
 
+// padRight pads s with spaces to width runes, unlike %-*s which counts bytes
+func padRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(0, width-utf8.RuneCountInString(s)))
+}
+
 func truncate(s string, max int) string {
 	if len(s) <= max {
 		return s
@@ -0,0 +1,102 @@
+package output
+
+import (
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// money is an amount in a currency
+type money struct {
+	amount   models.Money
+	currency string
+}
+
+func (m money) String() string {
+	return currency.Format(m.amount, m.currency)
+}
+
+// addMoney adds an amount to the total of its currency. Currencies are kept in the order
+// they first appear.
+func addMoney(totals []money, m money) []money {
+	for i := range totals {
+		if totals[i].currency == m.currency {
+			totals[i].amount += m.amount
+			return totals
+		}
+	}
+	return append(totals, m)
+}
+
+// entryCurrency returns the currency of an entry, which is the currency of its customer
+func entryCurrency(entry models.TimeEntry) string {
+	return entry.Project.Customer.CurrencyCode()
+}
+
+// lineCost returns the cost of an entry, rounded to öre when costs are rounded per line
+func (o Options) lineCost(entry models.TimeEntry) models.Money {
+	return o.Rounding.LineCost(entry.Hours, entry.HourlyRate)
+}
+
+// convert returns an amount in the currency totals are shown in: its own currency, or the
+// currency of the converter when there is one
+func (o Options) convert(amount models.Money, code string) (money, error) {
+	if o.Converter == nil {
+		return money{amount: amount, currency: code}, nil
+	}
+	converted, err := o.Converter.Convert(amount, code)
+	if err != nil {
+		return money{}, err
+	}
+	return money{amount: converted, currency: o.Converter.To}, nil
+}
+
+// totals holds the sums of a list of entries. Amounts in different currencies are never
+// added up: each currency has a total of its own, unless a converter turns them into one.
+type totals struct {
+	hours float64
+	cost  []money
+	vat   []money // only when VAT is included
+	gross []money // only when VAT is included
+	// reverseCharge names the customers invoiced with reverse charge, in order of appearance
+	reverseCharge []string
+}
+
+// sumEntries computes the totals of entries. The cost per customer is rounded to öre and
+// VAT is computed on it, the way it is invoiced, before any conversion.
+func (o Options) sumEntries(entries []models.TimeEntry) (totals, error) {
+	var t totals
+	var customers []models.Customer
+	net := make(map[uint]models.Money)
+	for _, entry := range entries {
+		customer := entry.Project.Customer
+		if _, seen := net[customer.ID]; !seen {
+			customers = append(customers, customer)
+		}
+		net[customer.ID] += o.lineCost(entry)
+		t.hours += entry.Hours
+	}
+
+	for _, customer := range customers {
+		net[customer.ID] = net[customer.ID].Round()
+		cost, err := o.convert(net[customer.ID], customer.CurrencyCode())
+		if err != nil {
+			return totals{}, err
+		}
+		t.cost = addMoney(t.cost, cost)
+
+		if o.DefaultVATRate == nil {
+			continue
+		}
+		vat, err := o.convert(models.VAT(net[customer.ID], customer.EffectiveVATRate(*o.DefaultVATRate)), customer.CurrencyCode())
+		if err != nil {
+			return totals{}, err
+		}
+		t.vat = addMoney(t.vat, vat)
+		t.gross = addMoney(t.gross, money{amount: cost.amount + vat.amount, currency: cost.currency})
+		if customer.ReverseCharge {
+			t.reverseCharge = append(t.reverseCharge, customer.Name)
+		}
+	}
+
+	return t, nil
+}
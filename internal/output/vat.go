@@ -0,0 +1,19 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// VATLabel returns the translated label of a VAT rate, e.g. "VAT 25%"
+func VATLabel(rate float64) string {
+	return fmt.Sprintf(i18n.T(i18n.KeyVATRate), strconv.FormatFloat(rate, 'f', -1, 64))
+}
+
+// entryVATRate returns the VAT rate charged for an entry, which is the rate of its customer
+func entryVATRate(entry models.TimeEntry, defaultRate float64) float64 {
+	return entry.Project.Customer.EffectiveVATRate(defaultRate)
+}
@@ -0,0 +1,156 @@
+package period
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/dateparse"
+	"github.com/LimerDev/worklog/internal/i18n"
+)
+
+// lastPattern matches rolling windows such as 30d, 6w or 3m
+var lastPattern = regexp.MustCompile(`^(\d+)([dwm])$`)
+
+// Spec selects a period of days. At most one way of selecting is used, in this order:
+// Week (with Year), Date, Last, Quarter (with FiscalYear or Year), FiscalYear, Month
+// (with Year), Year, and finally From/To. Without any of them the current month is selected.
+type Spec struct {
+	Date       string // a single day, in any form accepted by dateparse.Parse
+	From       string // first day of a range
+	To         string // last day of a range, inclusive
+	Week       int    // ISO week, 1-53
+	Month      int    // 1-12
+	Year       int    // calendar year for Week, Month and Quarter, or on its own
+	Quarter    string // Q1-Q4 or 1-4
+	FiscalYear int    // fiscal year, named by the calendar year it starts in
+	Last       string // rolling window ending today: 30d, 6w or 3m
+
+	// FiscalYearStartMonth is the first month of the fiscal year; zero means January
+	FiscalYearStartMonth time.Month
+}
+
+// IsSet reports whether any period was selected
+func (s Spec) IsSet() bool {
+	return s.Date != "" || s.From != "" || s.To != "" || s.Week != 0 || s.Month != 0 || s.Year != 0 ||
+		s.Quarter != "" || s.FiscalYear != 0 || s.Last != ""
+}
+
+// Resolve returns the half-open range [start, end) of dates selected by the spec, relative to now.
+// Either bound is zero for an open From/To range.
+func (s Spec) Resolve(now time.Time) (start, end time.Time, err error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	year := s.Year
+	if year == 0 {
+		year = today.Year()
+	}
+
+	switch {
+	case s.Week != 0:
+		// Only years whose December 28 falls in week 53 have a week 53
+		if _, lastWeek := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek(); s.Week < 1 || s.Week > lastWeek {
+			return start, end, fmt.Errorf("%s", i18n.T(i18n.KeyErrWeekRange))
+		}
+		start = dateparse.ISOWeekStart(year, s.Week)
+		return start, start.AddDate(0, 0, 7), nil
+
+	case s.Date != "":
+		start, err = dateparse.Parse(s.Date, now)
+		if err != nil {
+			return start, end, err
+		}
+		return start, start.AddDate(0, 0, 1), nil
+
+	case s.Last != "":
+		m := lastPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s.Last)))
+		if m == nil {
+			return start, end, fmt.Errorf(i18n.T(i18n.KeyErrInvalidLast), s.Last)
+		}
+		n, _ := strconv.Atoi(m[1])
+		if n < 1 {
+			return start, end, fmt.Errorf(i18n.T(i18n.KeyErrInvalidLast), s.Last)
+		}
+		// The window ends with today
+		end = today.AddDate(0, 0, 1)
+		switch m[2] {
+		case "d":
+			start = end.AddDate(0, 0, -n)
+		case "w":
+			start = end.AddDate(0, 0, -7*n)
+		case "m":
+			start = end.AddDate(0, -n, 0)
+		}
+		return start, end, nil
+
+	case s.Quarter != "":
+		quarter, err := parseQuarter(s.Quarter)
+		if err != nil {
+			return start, end, err
+		}
+		// Quarters of a fiscal year start at its first month, calendar quarters in January
+		if s.FiscalYear != 0 {
+			start = s.fiscalYearStart(s.FiscalYear)
+		} else {
+			start = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		}
+		start = start.AddDate(0, 3*(quarter-1), 0)
+		return start, start.AddDate(0, 3, 0), nil
+
+	case s.FiscalYear != 0:
+		start = s.fiscalYearStart(s.FiscalYear)
+		return start, start.AddDate(1, 0, 0), nil
+
+	case s.Month != 0:
+		if s.Month < 1 || s.Month > 12 {
+			return start, end, fmt.Errorf("%s", i18n.T(i18n.KeyErrMonthRange))
+		}
+		start = time.Date(year, time.Month(s.Month), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0), nil
+
+	case s.Year != 0:
+		start = time.Date(s.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, 0), nil
+
+	case s.From != "" || s.To != "":
+		if s.From != "" {
+			start, err = dateparse.Parse(s.From, now)
+			if err != nil {
+				return start, end, err
+			}
+		}
+		if s.To != "" {
+			end, err = dateparse.Parse(s.To, now)
+			if err != nil {
+				return start, end, err
+			}
+			end = end.AddDate(0, 0, 1) // Include the entire day
+		}
+		return start, end, nil
+	}
+
+	// No period given: the current month
+	start = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// fiscalYearStart returns the first day of the fiscal year that starts in the given calendar year
+func (s Spec) fiscalYearStart(year int) time.Time {
+	month := s.FiscalYearStartMonth
+	if month < time.January || month > time.December {
+		month = time.January
+	}
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// parseQuarter accepts Q1-Q4 or 1-4
+func parseQuarter(value string) (int, error) {
+	s := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "Q")
+	quarter, err := strconv.Atoi(s)
+	if err != nil || quarter < 1 || quarter > 4 {
+		return 0, fmt.Errorf(i18n.T(i18n.KeyErrInvalidQuarter), value)
+	}
+	return quarter, nil
+}
@@ -0,0 +1,107 @@
+package period
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestResolve(t *testing.T) {
+	// A Wednesday in the middle of the day, so that the time of day has to be dropped
+	now := time.Date(2025, time.November, 19, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		spec      Spec
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"default is the current month", Spec{}, date(2025, 11, 1), date(2025, 12, 1)},
+
+		{"quarter of this year", Spec{Quarter: "Q3"}, date(2025, 7, 1), date(2025, 10, 1)},
+		{"quarter of a given year", Spec{Quarter: "1", Year: 2024}, date(2024, 1, 1), date(2024, 4, 1)},
+		{"quarter in lower case", Spec{Quarter: "q4"}, date(2025, 10, 1), date(2026, 1, 1)},
+
+		{"fiscal year starting in January", Spec{FiscalYear: 2025}, date(2025, 1, 1), date(2026, 1, 1)},
+		{"fiscal year starting in July", Spec{FiscalYear: 2025, FiscalYearStartMonth: time.July}, date(2025, 7, 1), date(2026, 7, 1)},
+		{"first quarter of a fiscal year", Spec{FiscalYear: 2025, Quarter: "Q1", FiscalYearStartMonth: time.July}, date(2025, 7, 1), date(2025, 10, 1)},
+		{"fiscal quarter crossing the calendar year", Spec{FiscalYear: 2025, Quarter: "Q2", FiscalYearStartMonth: time.November}, date(2026, 2, 1), date(2026, 5, 1)},
+		{"fiscal quarter takes precedence over the year", Spec{FiscalYear: 2024, Quarter: "Q4", Year: 2020, FiscalYearStartMonth: time.May}, date(2025, 2, 1), date(2025, 5, 1)},
+
+		{"last day", Spec{Last: "1d"}, date(2025, 11, 19), date(2025, 11, 20)},
+		{"last 30 days", Spec{Last: "30d"}, date(2025, 10, 21), date(2025, 11, 20)},
+		{"last 6 weeks", Spec{Last: "6w"}, date(2025, 10, 9), date(2025, 11, 20)},
+		{"last 3 months", Spec{Last: "3m"}, date(2025, 8, 20), date(2025, 11, 20)},
+		{"last 12 months in upper case", Spec{Last: " 12M "}, date(2024, 11, 20), date(2025, 11, 20)},
+
+		{"week of this year", Spec{Week: 47}, date(2025, 11, 17), date(2025, 11, 24)},
+		{"week 53", Spec{Week: 53, Year: 2020}, date(2020, 12, 28), date(2021, 1, 4)},
+		{"week 53 ending in January", Spec{Week: 53, Year: 2026}, date(2026, 12, 28), date(2027, 1, 4)},
+		{"week 1 starting in December", Spec{Week: 1, Year: 2025}, date(2024, 12, 30), date(2025, 1, 6)},
+		{"week 1 starting in January", Spec{Week: 1, Year: 2024}, date(2024, 1, 1), date(2024, 1, 8)},
+		{"week 52 ending in January", Spec{Week: 52, Year: 2022}, date(2022, 12, 26), date(2023, 1, 2)},
+
+		{"month of this year", Spec{Month: 2}, date(2025, 2, 1), date(2025, 3, 1)},
+		{"month of a given year", Spec{Month: 12, Year: 2024}, date(2024, 12, 1), date(2025, 1, 1)},
+		{"whole year", Spec{Year: 2024}, date(2024, 1, 1), date(2025, 1, 1)},
+		{"single date", Spec{Date: "2025-02-28"}, date(2025, 2, 28), date(2025, 3, 1)},
+
+		{"from and to", Spec{From: "2025-11-01", To: "2025-11-30"}, date(2025, 11, 1), date(2025, 12, 1)},
+		{"open-ended from", Spec{From: "2025-11-01"}, date(2025, 11, 1), time.Time{}},
+		{"open-ended to", Spec{To: "2025-11-30"}, time.Time{}, date(2025, 12, 1)},
+		{"to across the year end", Spec{To: "2025-12-31"}, time.Time{}, date(2026, 1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := tt.spec.Resolve(now)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Resolve() = [%s, %s), want [%s, %s)", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestResolveInvalid(t *testing.T) {
+	now := time.Date(2025, time.November, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		spec Spec
+	}{
+		{"negative week", Spec{Week: -1}},
+		{"week 54", Spec{Week: 54, Year: 2020}},
+		{"week 53 in a year with 52 weeks", Spec{Week: 53, Year: 2025}},
+		{"quarter 5", Spec{Quarter: "Q5"}},
+		{"quarter that is not a number", Spec{Quarter: "first"}},
+		{"month 13", Spec{Month: 13}},
+		{"last without a unit", Spec{Last: "30"}},
+		{"last with an unknown unit", Spec{Last: "2y"}},
+		{"last zero days", Spec{Last: "0d"}},
+		{"invalid from", Spec{From: "2025-02-30"}},
+		{"invalid to", Spec{From: "2025-11-01", To: "2025-13-01"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if start, end, err := tt.spec.Resolve(now); err == nil {
+				t.Errorf("Resolve() = [%s, %s), want an error", start, end)
+			}
+		})
+	}
+}
+
+func TestIsSet(t *testing.T) {
+	if (Spec{FiscalYearStartMonth: time.July}).IsSet() {
+		t.Error("IsSet() = true for a spec with only a fiscal year start month")
+	}
+	if !(Spec{Last: "7d"}).IsSet() {
+		t.Error("IsSet() = false for a spec with a rolling window")
+	}
+}
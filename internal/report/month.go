@@ -0,0 +1,110 @@
+package report
+
+import (
+	"time"
+
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// MonthReport lists the hours and amounts of a period per customer, as a basis for invoicing
+type MonthReport struct {
+	From      time.Time // first day, or zero when open
+	To        time.Time // last day, or zero when open
+	Customers []CustomerSummary
+	Hours     float64
+	// Totals holds the grand total of each currency, in order of appearance, or a single
+	// total when the amounts were converted into one currency
+	Totals []MonthTotal
+
+	// ReverseCharge is set when some customer is invoiced with reverse charge
+	ReverseCharge bool
+}
+
+// MonthTotal is the sum of the customers billed in one currency
+type MonthTotal struct {
+	Currency string
+	Amount   models.Money // before VAT
+	VAT      models.Money
+	Gross    models.Money
+}
+
+// CustomerSummary holds the lines of one customer with their subtotal. The subtotal is
+// rounded to öre and VAT is computed on it, as on an invoice. The amounts are in the
+// currency of the customer.
+type CustomerSummary struct {
+	Name          string
+	Currency      string
+	Lines         []models.HoursSummary
+	Hours         float64
+	Amount        models.Money // before VAT
+	VATRate       float64
+	ReverseCharge bool
+	VAT           models.Money
+	Gross         models.Money
+}
+
+// BuildMonthReport groups summaries, ordered by customer as returned by the database, per
+// customer. The period is the half-open range [startDate, endDate). Customers without a
+// VAT rate of their own are charged defaultVATRate. The grand totals are kept per currency,
+// unless converter is given to convert them into one.
+func BuildMonthReport(summaries []models.HoursSummary, startDate, endDate time.Time, defaultVATRate float64, converter *currency.Converter) (*MonthReport, error) {
+	mr := &MonthReport{From: startDate}
+	if !endDate.IsZero() {
+		mr.To = endDate.AddDate(0, 0, -1)
+	}
+
+	for _, summary := range summaries {
+		if len(mr.Customers) == 0 || mr.Customers[len(mr.Customers)-1].Name != summary.Customer {
+			customer := models.Customer{Currency: summary.Currency, VATRate: summary.VATRate, ReverseCharge: summary.ReverseCharge}
+			mr.Customers = append(mr.Customers, CustomerSummary{
+				Name:          summary.Customer,
+				Currency:      customer.CurrencyCode(),
+				VATRate:       customer.EffectiveVATRate(defaultVATRate),
+				ReverseCharge: summary.ReverseCharge,
+			})
+		}
+		customer := &mr.Customers[len(mr.Customers)-1]
+		customer.Lines = append(customer.Lines, summary)
+		customer.Hours += summary.Hours
+		customer.Amount += summary.Amount
+		mr.Hours += summary.Hours
+	}
+
+	for i := range mr.Customers {
+		customer := &mr.Customers[i]
+		customer.Amount = customer.Amount.Round()
+		customer.VAT = models.VAT(customer.Amount, customer.VATRate)
+		customer.Gross = customer.Amount + customer.VAT
+		mr.ReverseCharge = mr.ReverseCharge || customer.ReverseCharge
+
+		total := MonthTotal{Currency: customer.Currency, Amount: customer.Amount, VAT: customer.VAT}
+		if converter != nil {
+			var err error
+			if total.Amount, err = converter.Convert(customer.Amount, customer.Currency); err != nil {
+				return nil, err
+			}
+			if total.VAT, err = converter.Convert(customer.VAT, customer.Currency); err != nil {
+				return nil, err
+			}
+			total.Currency = converter.To
+		}
+		mr.addTotal(total)
+	}
+
+	return mr, nil
+}
+
+// addTotal adds the amounts of a customer to the grand total of their currency
+func (mr *MonthReport) addTotal(total MonthTotal) {
+	total.Gross = total.Amount + total.VAT
+	for i := range mr.Totals {
+		if mr.Totals[i].Currency == total.Currency {
+			mr.Totals[i].Amount += total.Amount
+			mr.Totals[i].VAT += total.VAT
+			mr.Totals[i].Gross += total.Gross
+			return
+		}
+	}
+	mr.Totals = append(mr.Totals, total)
+}
@@ -0,0 +1,322 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/LimerDev/worklog/internal/currency"
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/models"
+	"github.com/LimerDev/worklog/internal/output"
+)
+
+// WriteMonthReport writes the report in the given format. Unknown formats are written as a table.
+func WriteMonthReport(mr *MonthReport, format output.Format, writer io.Writer) error {
+	switch format {
+	case output.FormatCSV:
+		return writeMonthCSV(mr, writer)
+	case output.FormatJSON:
+		return writeMonthJSON(mr, writer)
+	case output.FormatMarkdown:
+		return writeMonthMarkdown(mr, writer)
+	default:
+		return writeMonthTable(mr, writer)
+	}
+}
+
+func (mr *MonthReport) title() string {
+	return fmt.Sprintf(i18n.T(i18n.KeyReportMonthTitle), formatBound(mr.From), formatBound(mr.To))
+}
+
+// formatBound formats a day of the period, or "…" when that side of the period is open
+func formatBound(date time.Time) string {
+	if date.IsZero() {
+		return "…"
+	}
+	return date.Format("2006-01-02")
+}
+
+// monthHeader returns the column headers of the customer tables
+func monthHeader() []string {
+	return []string{
+		i18n.T(i18n.KeyGetHeaderProject),
+		i18n.T(i18n.KeyGetHeaderConsultant),
+		i18n.T(i18n.KeyGetHeaderHours),
+		i18n.T(i18n.KeyGetHeaderRate),
+		i18n.T(i18n.KeyReportHeaderAmount),
+	}
+}
+
+// monthRows returns the lines of a customer followed by its subtotal, the VAT and the
+// amount including VAT, formatted as text. The first two columns hold names, the rest hold
+// numbers.
+func monthRows(customer CustomerSummary) [][]string {
+	var rows [][]string
+	for _, line := range customer.Lines {
+		rows = append(rows, []string{
+			line.Project,
+			line.Consultant,
+			fmt.Sprintf("%.2f", line.Hours),
+			line.HourlyRate.String(),
+			line.Amount.String(),
+		})
+	}
+	vatLabel := output.VATLabel(customer.VATRate)
+	if customer.ReverseCharge {
+		vatLabel += " (" + i18n.T(i18n.KeyVATReverseCharge) + ")"
+	}
+	return append(rows,
+		[]string{i18n.T(i18n.KeyExportSubtotal), "", fmt.Sprintf("%.2f", customer.Hours), "", customer.Amount.String()},
+		[]string{vatLabel, "", "", "", customer.VAT.String()},
+		[]string{i18n.T(i18n.KeyVATGrossRow), "", "", "", customer.Gross.String()},
+	)
+}
+
+// monthTotalRows is the number of rows monthRows adds below the lines of a customer
+const monthTotalRows = 3
+
+const monthLabelColumns = 2
+
+func writeMonthTable(mr *MonthReport, writer io.Writer) error {
+	fmt.Fprintln(writer, mr.title())
+
+	header := monthHeader()
+	for _, customer := range mr.Customers {
+		rows := monthRows(customer)
+
+		widths := make([]int, len(header))
+		for col, cell := range header {
+			widths[col] = utf8.RuneCountInString(cell)
+		}
+		for _, row := range rows {
+			for col, cell := range row {
+				widths[col] = max(widths[col], utf8.RuneCountInString(cell))
+			}
+		}
+
+		fmt.Fprintf(writer, "\n%s (%s)\n", customer.Name, customer.Currency)
+		for _, row := range append([][]string{header}, rows...) {
+			cells := make([]string, len(row))
+			for col, cell := range row {
+				if col < monthLabelColumns {
+					cells[col] = padRight(cell, widths[col])
+				} else {
+					cells[col] = padLeft(cell, widths[col])
+				}
+			}
+			fmt.Fprintln(writer, strings.Join(cells, "   "))
+		}
+		if customer.ReverseCharge {
+			fmt.Fprintln(writer, i18n.T(i18n.KeyVATReverseChargeNote))
+		}
+	}
+
+	fmt.Fprintf(writer, "\n%s: %.2f\n", i18n.T(i18n.KeyGetTotalHours), mr.Hours)
+	for _, total := range mr.Totals {
+		fmt.Fprintf(writer, "%s: %s\n", i18n.T(i18n.KeyReportMonthTotalAmount), currency.Format(total.Amount, total.Currency))
+		fmt.Fprintf(writer, "%s: %s\n", i18n.T(i18n.KeyVATTotal), currency.Format(total.VAT, total.Currency))
+		fmt.Fprintf(writer, "%s: %s\n", i18n.T(i18n.KeyVATGross), currency.Format(total.Gross, total.Currency))
+	}
+
+	return nil
+}
+
+func writeMonthMarkdown(mr *MonthReport, writer io.Writer) error {
+	fmt.Fprintf(writer, "# %s\n", mr.title())
+
+	header := monthHeader()
+	align := make([]string, len(header))
+	for col, cell := range header {
+		header[col] = escapeMarkdown(cell)
+		align[col] = "---"
+		if col >= monthLabelColumns {
+			align[col] = "---:"
+		}
+	}
+
+	for _, customer := range mr.Customers {
+		fmt.Fprintf(writer, "\n## %s (%s)\n\n", escapeMarkdown(customer.Name), customer.Currency)
+		fmt.Fprintf(writer, "| %s |\n", strings.Join(header, " | "))
+		fmt.Fprintf(writer, "| %s |\n", strings.Join(align, " | "))
+
+		rows := monthRows(customer)
+		for r, row := range rows {
+			for col, cell := range row {
+				row[col] = escapeMarkdown(cell)
+				// The subtotal, VAT and total rows are set in bold
+				if r >= len(rows)-monthTotalRows && cell != "" {
+					row[col] = "**" + row[col] + "**"
+				}
+			}
+			fmt.Fprintf(writer, "| %s |\n", strings.Join(row, " | "))
+		}
+		if customer.ReverseCharge {
+			fmt.Fprintf(writer, "\n*%s*\n", escapeMarkdown(i18n.T(i18n.KeyVATReverseChargeNote)))
+		}
+	}
+
+	// Lines end in two spaces, a line break in markdown
+	fmt.Fprintf(writer, "\n**%s:** %.2f  \n", i18n.T(i18n.KeyGetTotalHours), mr.Hours)
+	for _, total := range mr.Totals {
+		fmt.Fprintf(writer, "**%s:** %s  \n", i18n.T(i18n.KeyReportMonthTotalAmount), currency.Format(total.Amount, total.Currency))
+		fmt.Fprintf(writer, "**%s:** %s  \n", i18n.T(i18n.KeyVATTotal), currency.Format(total.VAT, total.Currency))
+		fmt.Fprintf(writer, "**%s:** %s  \n", i18n.T(i18n.KeyVATGross), currency.Format(total.Gross, total.Currency))
+	}
+
+	return nil
+}
+
+func writeMonthCSV(mr *MonthReport, writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	defer csvWriter.Flush()
+
+	header := append([]string{i18n.T(i18n.KeyGetHeaderCustomer)}, monthHeader()...)
+	header = append(header, i18n.T(i18n.KeyGetHeaderCurrency))
+	if err := csvWriter.Write(header); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteCSVHeader), err)
+	}
+
+	// Every row names its customer, so the file can be filtered and pivoted as is
+	for _, customer := range mr.Customers {
+		for _, row := range monthRows(customer) {
+			row = append([]string{customer.Name}, row...)
+			if err := csvWriter.Write(append(row, customer.Currency)); err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteCSVRow), err)
+			}
+		}
+	}
+
+	// One set of totals rows per currency; the total hours go on the first
+	var totalsRows [][]string
+	for i, total := range mr.Totals {
+		hours := ""
+		if i == 0 {
+			hours = fmt.Sprintf("%.2f", mr.Hours)
+		}
+		totalsRows = append(totalsRows,
+			[]string{i18n.T(i18n.KeyExportTotal), "", "", hours, "", total.Amount.String(), total.Currency},
+			[]string{i18n.T(i18n.KeyVATRow), "", "", "", "", total.VAT.String(), total.Currency},
+			[]string{i18n.T(i18n.KeyVATGrossRow), "", "", "", "", total.Gross.String(), total.Currency},
+		)
+	}
+	for _, customer := range mr.Customers {
+		if customer.ReverseCharge {
+			totalsRows = append(totalsRows, []string{customer.Name, i18n.T(i18n.KeyVATReverseChargeNote), "", "", "", "", ""})
+		}
+	}
+	for _, row := range totalsRows {
+		if err := csvWriter.Write(row); err != nil {
+			return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteTotalsRow), err)
+		}
+	}
+
+	return nil
+}
+
+// JSONMonthReport represents a report per customer in JSON format. From and To are
+// omitted when that side of the period is open. The amounts and currency are given when
+// all customers are billed in one currency, or were converted into one; otherwise Totals
+// holds the total of each currency.
+type JSONMonthReport struct {
+	From      string              `json:"from,omitempty"`
+	To        string              `json:"to,omitempty"`
+	Customers []JSONMonthCustomer `json:"customers"`
+	Hours     float64             `json:"hours"`
+	Amount    *models.Money       `json:"amount,omitempty"`
+	VAT       *models.Money       `json:"vat,omitempty"`
+	Gross     *models.Money       `json:"gross,omitempty"`
+	Currency  string              `json:"currency,omitempty"`
+	Totals    []JSONMonthTotal    `json:"totals,omitempty"`
+}
+
+// JSONMonthTotal represents the total of the customers billed in one currency
+type JSONMonthTotal struct {
+	Currency string       `json:"currency"`
+	Amount   models.Money `json:"amount"`
+	VAT      models.Money `json:"vat"`
+	Gross    models.Money `json:"gross"`
+}
+
+// JSONMonthCustomer represents the lines of one customer with their subtotal. The
+// reverse charge note is included when the customer is invoiced with reverse charge.
+type JSONMonthCustomer struct {
+	Customer          string          `json:"customer"`
+	Currency          string          `json:"currency"`
+	Lines             []JSONMonthLine `json:"lines"`
+	Hours             float64         `json:"hours"`
+	Amount            models.Money    `json:"amount"`
+	VATRate           float64         `json:"vat_rate"`
+	VAT               models.Money    `json:"vat"`
+	Gross             models.Money    `json:"gross"`
+	ReverseCharge     bool            `json:"reverse_charge"`
+	ReverseChargeNote string          `json:"reverse_charge_note,omitempty"`
+}
+
+// JSONMonthLine represents the work of one consultant on a project at one hourly rate
+type JSONMonthLine struct {
+	Project    string       `json:"project"`
+	Consultant string       `json:"consultant"`
+	Entries    int          `json:"entries"`
+	Hours      float64      `json:"hours"`
+	HourlyRate models.Money `json:"hourly_rate"`
+	Amount     models.Money `json:"amount"`
+}
+
+func writeMonthJSON(mr *MonthReport, writer io.Writer) error {
+	doc := JSONMonthReport{
+		Customers: []JSONMonthCustomer{},
+		Hours:     mr.Hours,
+	}
+	if len(mr.Totals) == 1 {
+		doc.Amount = &mr.Totals[0].Amount
+		doc.VAT = &mr.Totals[0].VAT
+		doc.Gross = &mr.Totals[0].Gross
+		doc.Currency = mr.Totals[0].Currency
+	} else {
+		for _, total := range mr.Totals {
+			doc.Totals = append(doc.Totals, JSONMonthTotal(total))
+		}
+	}
+	if !mr.From.IsZero() {
+		doc.From = mr.From.Format("2006-01-02")
+	}
+	if !mr.To.IsZero() {
+		doc.To = mr.To.Format("2006-01-02")
+	}
+
+	for _, customer := range mr.Customers {
+		jsonCustomer := JSONMonthCustomer{
+			Customer:      customer.Name,
+			Currency:      customer.Currency,
+			Hours:         customer.Hours,
+			Amount:        customer.Amount,
+			VATRate:       customer.VATRate,
+			VAT:           customer.VAT,
+			Gross:         customer.Gross,
+			ReverseCharge: customer.ReverseCharge,
+		}
+		if customer.ReverseCharge {
+			jsonCustomer.ReverseChargeNote = i18n.T(i18n.KeyVATReverseChargeNote)
+		}
+		for _, line := range customer.Lines {
+			jsonCustomer.Lines = append(jsonCustomer.Lines, JSONMonthLine{
+				Project:    line.Project,
+				Consultant: line.Consultant,
+				Entries:    line.Entries,
+				Hours:      line.Hours,
+				HourlyRate: line.HourlyRate,
+				Amount:     line.Amount,
+			})
+		}
+		doc.Customers = append(doc.Customers, jsonCustomer)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
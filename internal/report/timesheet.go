@@ -0,0 +1,204 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/LimerDev/worklog/internal/models"
+)
+
+// DaysPerWeek is the number of day columns of a timesheet, Monday first
+const DaysPerWeek = 7
+
+// workDays is the number of days, counted from Monday, that are checked against the expected hours
+const workDays = 5
+
+// Timesheet is a week of hours laid out as a grid with one column per day
+type Timesheet struct {
+	Year  int
+	Week  int
+	Start time.Time // Monday of the week
+	// ExpectedDailyHours marks weekdays with less time as short; 0 disables the check
+	ExpectedDailyHours float64
+	// ConsultantDailyHours overrides ExpectedDailyHours for consultants with a weekly capacity
+	ConsultantDailyHours map[string]float64
+	// Team lays out one row per consultant in a single sheet instead of a sheet per consultant
+	Team   bool
+	Sheets []Sheet
+	Days   [DaysPerWeek]float64
+	Total  float64
+}
+
+// Sheet is the grid of one consultant, or of the whole team
+type Sheet struct {
+	Consultant string // empty for the team sheet
+	Rows       []Row
+	Days       [DaysPerWeek]float64
+	Total      float64
+}
+
+// Row holds the hours per day of a project, or of a consultant on the team sheet
+type Row struct {
+	Consultant string
+	Project    string
+	Customer   string
+	Days       [DaysPerWeek]float64
+	Total      float64
+}
+
+// BuildTimesheet lays out the entries of the ISO week starting on weekStart. Without team
+// there is a sheet per consultant with a row per project; with team there is one sheet with
+// a row per consultant. Sheets and rows are sorted by name. Entries outside the week are ignored.
+func BuildTimesheet(entries []models.TimeEntry, year, week int, weekStart time.Time, expectedDailyHours float64, team bool) *Timesheet {
+	ts := &Timesheet{
+		Year:               year,
+		Week:               week,
+		Start:              weekStart,
+		ExpectedDailyHours: expectedDailyHours,
+		Team:               team,
+	}
+
+	sheetIndex := make(map[string]int)
+	rowIndex := make(map[string]int)
+
+	for _, entry := range entries {
+		day := int(entry.Date.Sub(weekStart).Hours() / 24)
+		if day < 0 || day >= DaysPerWeek {
+			continue
+		}
+
+		sheetKey := entry.Consultant.Name
+		rowKey := fmt.Sprintf("%s\x00%s\x00%s", entry.Consultant.Name, entry.Project.Name, entry.Project.Customer.Name)
+		row := Row{Consultant: entry.Consultant.Name, Project: entry.Project.Name, Customer: entry.Project.Customer.Name}
+		if team {
+			sheetKey = ""
+			rowKey = entry.Consultant.Name
+			row = Row{Consultant: entry.Consultant.Name}
+		}
+
+		s, exists := sheetIndex[sheetKey]
+		if !exists {
+			s = len(ts.Sheets)
+			sheetIndex[sheetKey] = s
+			ts.Sheets = append(ts.Sheets, Sheet{Consultant: sheetKey})
+		}
+		sheet := &ts.Sheets[s]
+
+		r, exists := rowIndex[rowKey]
+		if !exists {
+			r = len(sheet.Rows)
+			rowIndex[rowKey] = r
+			sheet.Rows = append(sheet.Rows, row)
+		}
+
+		sheet.Rows[r].Days[day] += entry.Hours
+		sheet.Rows[r].Total += entry.Hours
+		sheet.Days[day] += entry.Hours
+		sheet.Total += entry.Hours
+		ts.Days[day] += entry.Hours
+		ts.Total += entry.Hours
+	}
+
+	sort.Slice(ts.Sheets, func(i, j int) bool { return ts.Sheets[i].Consultant < ts.Sheets[j].Consultant })
+	for _, sheet := range ts.Sheets {
+		sort.Slice(sheet.Rows, func(i, j int) bool {
+			a, b := sheet.Rows[i], sheet.Rows[j]
+			if a.Consultant != b.Consultant {
+				return a.Consultant < b.Consultant
+			}
+			if a.Project != b.Project {
+				return a.Project < b.Project
+			}
+			return a.Customer < b.Customer
+		})
+	}
+
+	return ts
+}
+
+// Date returns the date of a day column
+func (ts *Timesheet) Date(day int) time.Time {
+	return ts.Start.AddDate(0, 0, day)
+}
+
+// End returns the Sunday of the week
+func (ts *Timesheet) End() time.Time {
+	return ts.Date(DaysPerWeek - 1)
+}
+
+// ConsultantDailyHours returns the hours per weekday expected of the consultants of the
+// entries that have a weekly capacity, keyed by name
+func ConsultantDailyHours(entries []models.TimeEntry) map[string]float64 {
+	hours := make(map[string]float64)
+	for _, entry := range entries {
+		if entry.Consultant.WeeklyCapacity > 0 {
+			hours[entry.Consultant.Name] = entry.Consultant.DailyCapacity()
+		}
+	}
+	return hours
+}
+
+// ExpectedHours returns the hours per weekday expected of a consultant: their own from
+// ConsultantDailyHours, or else ExpectedDailyHours
+func (ts *Timesheet) ExpectedHours(consultant string) float64 {
+	if hours, ok := ts.ConsultantDailyHours[consultant]; ok {
+		return hours
+	}
+	return ts.ExpectedDailyHours
+}
+
+// checksHours reports whether the hours of anyone on the timesheet are checked
+func (ts *Timesheet) checksHours() bool {
+	if ts.ExpectedDailyHours > 0 {
+		return true
+	}
+	for _, hours := range ts.ConsultantDailyHours {
+		if hours > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsShort reports whether hours worked by a consultant on a day fall under the hours
+// expected of them. Only Monday to Friday are checked.
+func (ts *Timesheet) IsShort(consultant string, day int, hours float64) bool {
+	expected := ts.ExpectedHours(consultant)
+	return expected > 0 && day < workDays && hours < expected
+}
+
+// personDays returns the per-person day totals that are checked against the expected hours,
+// and whose they are: the sheet totals of a consultant, or the row of a consultant on the
+// team sheet.
+func (ts *Timesheet) personDays(sheet Sheet, row *Row) ([DaysPerWeek]float64, string, bool) {
+	if ts.Team && row != nil {
+		return row.Days, row.Consultant, true
+	}
+	if !ts.Team && row == nil {
+		return sheet.Days, sheet.Consultant, true
+	}
+	return [DaysPerWeek]float64{}, "", false
+}
+
+// ShortDays returns the days of a sheet (row == nil) or team row that fall under the expected
+// hours. Project rows and the team totals are not checked, since the expectation is per person.
+func (ts *Timesheet) ShortDays(sheet Sheet, row *Row) []time.Time {
+	days, consultant, ok := ts.personDays(sheet, row)
+	if !ok {
+		return nil
+	}
+	var short []time.Time
+	for day, hours := range days {
+		if ts.IsShort(consultant, day, hours) {
+			short = append(short, ts.Date(day))
+		}
+	}
+	return short
+}
+
+// isShortCell reports whether a single cell of a sheet (row == nil) or team row is short
+func (ts *Timesheet) isShortCell(sheet Sheet, row *Row, day int) bool {
+	days, consultant, ok := ts.personDays(sheet, row)
+	return ok && ts.IsShort(consultant, day, days[day])
+}
@@ -0,0 +1,422 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/LimerDev/worklog/internal/i18n"
+	"github.com/LimerDev/worklog/internal/output"
+)
+
+// WriteTimesheet writes the timesheet in the given format. Unknown formats are written
+// as a table, like output.GetFormatter does.
+func WriteTimesheet(ts *Timesheet, format output.Format, writer io.Writer) error {
+	switch format {
+	case output.FormatCSV:
+		return writeTimesheetCSV(ts, writer)
+	case output.FormatJSON:
+		return writeTimesheetJSON(ts, writer)
+	case output.FormatMarkdown:
+		return writeTimesheetMarkdown(ts, writer)
+	default:
+		return writeTimesheetTable(ts, writer)
+	}
+}
+
+// gridCell is a rendered cell of a timesheet; short marks a day under the expected hours
+type gridCell struct {
+	text  string
+	short bool
+}
+
+// grid is a sheet rendered as text, shared by the table and Markdown output.
+// The first labels columns hold names, the rest hold hours.
+type grid struct {
+	title  string
+	labels int
+	header []string
+	rows   [][]gridCell
+}
+
+// dayHeaders returns the column headers of the days, e.g. "Mon 24"
+func (ts *Timesheet) dayHeaders() []string {
+	names := strings.Split(i18n.T(i18n.KeyReportTimesheetDays), ",")
+	headers := make([]string, DaysPerWeek)
+	for day := range headers {
+		name := ""
+		if day < len(names) {
+			name = strings.TrimSpace(names[day])
+		}
+		headers[day] = fmt.Sprintf("%s %d", name, ts.Date(day).Day())
+	}
+	return headers
+}
+
+// labelHeaders returns the headers of the name columns of a sheet
+func (ts *Timesheet) labelHeaders() []string {
+	if ts.Team {
+		return []string{i18n.T(i18n.KeyGetHeaderConsultant)}
+	}
+	return []string{i18n.T(i18n.KeyGetHeaderProject), i18n.T(i18n.KeyGetHeaderCustomer)}
+}
+
+func (ts *Timesheet) grid(sheet Sheet) grid {
+	g := grid{title: sheet.Consultant}
+	if ts.Team {
+		g.title = i18n.T(i18n.KeyReportTimesheetTeam)
+	}
+
+	labelHeaders := ts.labelHeaders()
+	g.labels = len(labelHeaders)
+	g.header = append(append(labelHeaders, ts.dayHeaders()...), i18n.T(i18n.KeyExportTotal))
+
+	hoursCell := func(hours float64, short bool) gridCell {
+		if hours == 0 && !short {
+			return gridCell{}
+		}
+		return gridCell{text: fmt.Sprintf("%.2f", hours), short: short}
+	}
+
+	for i := range sheet.Rows {
+		row := &sheet.Rows[i]
+		var cells []gridCell
+		if ts.Team {
+			cells = append(cells, gridCell{text: row.Consultant})
+		} else {
+			cells = append(cells, gridCell{text: row.Project}, gridCell{text: row.Customer})
+		}
+		for day, hours := range row.Days {
+			cells = append(cells, hoursCell(hours, ts.isShortCell(sheet, row, day)))
+		}
+		g.rows = append(g.rows, append(cells, hoursCell(row.Total, false)))
+	}
+
+	totals := make([]gridCell, g.labels)
+	totals[0] = gridCell{text: i18n.T(i18n.KeyExportTotal)}
+	for day, hours := range sheet.Days {
+		totals = append(totals, hoursCell(hours, ts.isShortCell(sheet, nil, day)))
+	}
+	g.rows = append(g.rows, append(totals, hoursCell(sheet.Total, false)))
+
+	return g
+}
+
+// hasShortDays reports whether any day of the timesheet falls under the expected hours
+func (ts *Timesheet) hasShortDays() bool {
+	for _, sheet := range ts.Sheets {
+		if len(ts.ShortDays(sheet, nil)) > 0 {
+			return true
+		}
+		for i := range sheet.Rows {
+			if len(ts.ShortDays(sheet, &sheet.Rows[i])) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// expectedHoursLegend describes the hours expected per day, e.g. "8.00", or
+// "8.00 (Anna Andersson 6.40)" when consultants on the timesheet have their own capacity
+func (ts *Timesheet) expectedHoursLegend() string {
+	var names []string
+	for _, sheet := range ts.Sheets {
+		if !ts.Team {
+			names = append(names, sheet.Consultant)
+			continue
+		}
+		for _, row := range sheet.Rows {
+			names = append(names, row.Consultant)
+		}
+	}
+
+	var own []string
+	for _, name := range names {
+		if hours, ok := ts.ConsultantDailyHours[name]; ok {
+			own = append(own, fmt.Sprintf("%s %.2f", name, hours))
+		}
+	}
+	switch {
+	case len(own) == 0:
+		return fmt.Sprintf("%.2f", ts.ExpectedDailyHours)
+	case len(own) == len(names) || ts.ExpectedDailyHours <= 0:
+		return strings.Join(own, ", ")
+	default:
+		return fmt.Sprintf("%.2f (%s)", ts.ExpectedDailyHours, strings.Join(own, ", "))
+	}
+}
+
+func (ts *Timesheet) title() string {
+	return fmt.Sprintf(i18n.T(i18n.KeyReportTimesheetTitle), ts.Week, ts.Year,
+		ts.Start.Format("2006-01-02"), ts.End().Format("2006-01-02"))
+}
+
+func writeTimesheetTable(ts *Timesheet, writer io.Writer) error {
+	fmt.Fprintln(writer, ts.title())
+
+	// Hours get a one character suffix, "*" on short days, when the check is enabled
+	marker := func(cell gridCell) string {
+		switch {
+		case !ts.checksHours():
+			return ""
+		case cell.short:
+			return "*"
+		default:
+			return " "
+		}
+	}
+
+	for _, sheet := range ts.Sheets {
+		g := ts.grid(sheet)
+
+		widths := make([]int, len(g.header))
+		for col, header := range g.header {
+			widths[col] = utf8.RuneCountInString(header)
+		}
+		for _, row := range g.rows {
+			for col, cell := range row {
+				n := utf8.RuneCountInString(cell.text)
+				if col >= g.labels {
+					n += len(marker(cell))
+				}
+				widths[col] = max(widths[col], n)
+			}
+		}
+
+		fmt.Fprintf(writer, "\n%s\n", g.title)
+		cells := make([]string, len(g.header))
+		for col, header := range g.header {
+			if col < g.labels {
+				cells[col] = padRight(header, widths[col])
+			} else {
+				cells[col] = padLeft(header, widths[col])
+			}
+		}
+		fmt.Fprintln(writer, strings.Join(cells, "   "))
+
+		for _, row := range g.rows {
+			for col, cell := range row {
+				if col < g.labels {
+					cells[col] = padRight(cell.text, widths[col])
+				} else {
+					cells[col] = padLeft(cell.text+marker(cell), widths[col])
+				}
+			}
+			fmt.Fprintln(writer, strings.TrimRight(strings.Join(cells, "   "), " "))
+		}
+	}
+
+	if len(ts.Sheets) > 1 && !ts.Team {
+		fmt.Fprintf(writer, "\n%s: %.2f\n", i18n.T(i18n.KeyGetTotalHours), ts.Total)
+	}
+	if ts.hasShortDays() {
+		fmt.Fprintf(writer, "\n"+i18n.T(i18n.KeyReportTimesheetLegendTable)+"\n", ts.expectedHoursLegend())
+	}
+
+	return nil
+}
+
+func writeTimesheetMarkdown(ts *Timesheet, writer io.Writer) error {
+	fmt.Fprintf(writer, "# %s\n", ts.title())
+
+	for _, sheet := range ts.Sheets {
+		g := ts.grid(sheet)
+
+		fmt.Fprintf(writer, "\n## %s\n\n", escapeMarkdown(g.title))
+		align := make([]string, len(g.header))
+		for col, header := range g.header {
+			g.header[col] = escapeMarkdown(header)
+			align[col] = "---"
+			if col >= g.labels {
+				align[col] = "---:"
+			}
+		}
+		fmt.Fprintf(writer, "| %s |\n", strings.Join(g.header, " | "))
+		fmt.Fprintf(writer, "| %s |\n", strings.Join(align, " | "))
+
+		for _, row := range g.rows {
+			cells := make([]string, len(row))
+			for col, cell := range row {
+				text := escapeMarkdown(cell.text)
+				if cell.short {
+					text = "**" + text + "**"
+				}
+				cells[col] = text
+			}
+			fmt.Fprintf(writer, "| %s |\n", strings.Join(cells, " | "))
+		}
+	}
+
+	if len(ts.Sheets) > 1 && !ts.Team {
+		fmt.Fprintf(writer, "\n%s: %.2f\n", i18n.T(i18n.KeyGetTotalHours), ts.Total)
+	}
+	if ts.hasShortDays() {
+		fmt.Fprintf(writer, "\n"+i18n.T(i18n.KeyReportTimesheetLegendMarkdown)+"\n", ts.expectedHoursLegend())
+	}
+
+	return nil
+}
+
+func writeTimesheetCSV(ts *Timesheet, writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	defer csvWriter.Flush()
+
+	// Days are named by date so that the file can be read back without knowing the week
+	header := []string{i18n.T(i18n.KeyGetHeaderConsultant)}
+	if !ts.Team {
+		header = append(header, i18n.T(i18n.KeyGetHeaderProject), i18n.T(i18n.KeyGetHeaderCustomer))
+	}
+	for day := 0; day < DaysPerWeek; day++ {
+		header = append(header, ts.Date(day).Format("2006-01-02"))
+	}
+	header = append(header, i18n.T(i18n.KeyExportTotal))
+	if err := csvWriter.Write(header); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteCSVHeader), err)
+	}
+
+	hoursRow := func(labels []string, days [DaysPerWeek]float64, total float64) []string {
+		row := labels
+		for _, hours := range days {
+			row = append(row, fmt.Sprintf("%.2f", hours))
+		}
+		return append(row, fmt.Sprintf("%.2f", total))
+	}
+
+	for _, sheet := range ts.Sheets {
+		for _, row := range sheet.Rows {
+			labels := []string{row.Consultant}
+			if !ts.Team {
+				labels = append(labels, row.Project, row.Customer)
+			}
+			if err := csvWriter.Write(hoursRow(labels, row.Days, row.Total)); err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteCSVRow), err)
+			}
+		}
+
+		// Each consultant gets a totals row; the team sheet shares the grand totals row below
+		if !ts.Team {
+			labels := []string{sheet.Consultant, i18n.T(i18n.KeyExportTotal), ""}
+			if err := csvWriter.Write(hoursRow(labels, sheet.Days, sheet.Total)); err != nil {
+				return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteTotalsRow), err)
+			}
+		}
+	}
+
+	labels := []string{i18n.T(i18n.KeyExportTotal)}
+	if !ts.Team {
+		labels = []string{"", i18n.T(i18n.KeyExportTotal), ""}
+	}
+	if err := csvWriter.Write(hoursRow(labels, ts.Days, ts.Total)); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T(i18n.KeyErrWriteTotalsRow), err)
+	}
+
+	return nil
+}
+
+// JSONTimesheet represents a timesheet in JSON format. Days are listed Monday first,
+// matching Dates.
+type JSONTimesheet struct {
+	Year               int                  `json:"year"`
+	Week               int                  `json:"week"`
+	Dates              []string             `json:"dates"`
+	ExpectedDailyHours float64              `json:"expected_daily_hours,omitempty"`
+	Sheets             []JSONTimesheetSheet `json:"sheets"`
+	Days               [DaysPerWeek]float64 `json:"days"`
+	Total              float64              `json:"total"`
+}
+
+// JSONTimesheetSheet represents the grid of one consultant, or of the whole team.
+// ExpectedDailyHours is set when the consultant has a weekly capacity of their own.
+type JSONTimesheetSheet struct {
+	Consultant         string               `json:"consultant,omitempty"`
+	ExpectedDailyHours float64              `json:"expected_daily_hours,omitempty"`
+	Rows               []JSONTimesheetRow   `json:"rows"`
+	Days               [DaysPerWeek]float64 `json:"days"`
+	Total              float64              `json:"total"`
+	ShortDays          []string             `json:"short_days,omitempty"`
+}
+
+// JSONTimesheetRow represents the hours per day of a project, or of a consultant on the team
+// sheet. ExpectedDailyHours is set for a consultant with a weekly capacity of their own.
+type JSONTimesheetRow struct {
+	Consultant         string               `json:"consultant,omitempty"`
+	ExpectedDailyHours float64              `json:"expected_daily_hours,omitempty"`
+	Project            string               `json:"project,omitempty"`
+	Customer           string               `json:"customer,omitempty"`
+	Days               [DaysPerWeek]float64 `json:"days"`
+	Total              float64              `json:"total"`
+	ShortDays          []string             `json:"short_days,omitempty"`
+}
+
+func writeTimesheetJSON(ts *Timesheet, writer io.Writer) error {
+	doc := JSONTimesheet{
+		Year:               ts.Year,
+		Week:               ts.Week,
+		ExpectedDailyHours: ts.ExpectedDailyHours,
+		Sheets:             []JSONTimesheetSheet{},
+		Days:               ts.Days,
+		Total:              ts.Total,
+	}
+	for day := 0; day < DaysPerWeek; day++ {
+		doc.Dates = append(doc.Dates, ts.Date(day).Format("2006-01-02"))
+	}
+
+	for _, sheet := range ts.Sheets {
+		jsonSheet := JSONTimesheetSheet{
+			Consultant:         sheet.Consultant,
+			ExpectedDailyHours: ts.ConsultantDailyHours[sheet.Consultant],
+			Rows:               []JSONTimesheetRow{},
+			Days:               sheet.Days,
+			Total:              sheet.Total,
+			ShortDays:          formatDates(ts.ShortDays(sheet, nil)),
+		}
+		for i, row := range sheet.Rows {
+			jsonRow := JSONTimesheetRow{
+				Project:   row.Project,
+				Customer:  row.Customer,
+				Days:      row.Days,
+				Total:     row.Total,
+				ShortDays: formatDates(ts.ShortDays(sheet, &sheet.Rows[i])),
+			}
+			if ts.Team {
+				jsonRow.Consultant = row.Consultant
+				jsonRow.ExpectedDailyHours = ts.ConsultantDailyHours[row.Consultant]
+			}
+			jsonSheet.Rows = append(jsonSheet.Rows, jsonRow)
+		}
+		doc.Sheets = append(doc.Sheets, jsonSheet)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+// formatDates formats dates as YYYY-MM-DD
+func formatDates(dates []time.Time) []string {
+	var formatted []string
+	for _, date := range dates {
+		formatted = append(formatted, date.Format("2006-01-02"))
+	}
+	return formatted
+}
+
+// padRight pads s with spaces to width runes
+func padRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(0, width-utf8.RuneCountInString(s)))
+}
+
+// padLeft right-aligns s in width runes
+func padLeft(s string, width int) string {
+	return strings.Repeat(" ", max(0, width-utf8.RuneCountInString(s))) + s
+}
+
+// escapeMarkdown keeps pipes in names from splitting Markdown table cells
+func escapeMarkdown(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}